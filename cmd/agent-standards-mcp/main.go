@@ -4,6 +4,7 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log/slog"
 	"os"
 
@@ -23,27 +24,20 @@ var (
 	builtBy = "unknown"
 )
 
-// buildInfo holds build-time information
-type buildInfo struct {
-	version string
-	commit  string
-	date    string
-	builtBy string
-}
-
 // getBuildInfo returns build-time information
-func getBuildInfo() buildInfo {
-	return buildInfo{
-		version: version,
-		commit:  commit,
-		date:    date,
-		builtBy: builtBy,
+func getBuildInfo() server.BuildInfo {
+	return server.BuildInfo{
+		Version: version,
+		Commit:  commit,
+		Date:    date,
+		BuiltBy: builtBy,
 	}
 }
 
 func main() {
 	// Add version flag
 	showVersion := flag.Bool("version", false, "Show version information")
+	selfTest := flag.Bool("self-test", false, "Check that standards can be listed and loaded, then exit")
 	flag.Parse()
 
 	if *showVersion {
@@ -54,10 +48,10 @@ func main() {
 			ReplaceAttr: nil,
 		}))
 		logger.Info("agent-standards-mcp version info",
-			"version", info.version,
-			"commit", info.commit,
-			"built", info.date,
-			"built_by", info.builtBy,
+			"version", info.Version,
+			"commit", info.Commit,
+			"built", info.Date,
+			"built_by", info.BuiltBy,
 		)
 		os.Exit(0)
 	}
@@ -88,36 +82,83 @@ func main() {
 
 	// Test audit logging
 	info := getBuildInfo()
-	auditLogger.LogClientRequest("test-client", "startup", map[string]any{"version": info.version})
-
-	// Log server startup
-	structuredLogger.Info("Starting agent-standards-mcp server",
-		"log_level", cfg.GetLogLevel(),
-		"standards_folder", cfg.GetFolder(),
-		"max_standards", cfg.GetMaxStandards(),
-		"max_standard_size", cfg.GetMaxStandardSize(),
-	)
+	auditLogger.LogClientRequest("test-client", "startup", map[string]any{"version": info.Version})
 
 	// Create standard loader
-	standardLoader := standards.NewFileStandardLoader()
+	standardLoader, err := newStandardLoader(cfg)
+	if err != nil {
+		structuredLogger.Error("Failed to create standard loader", "error", err)
+		os.Exit(1)
+	}
 
 	// Create MCP server
-	mcpServer, err := server.New(cfg, structuredLogger, auditLogger, standardLoader)
+	mcpServer, err := server.New(cfg, structuredLogger, auditLogger, standardLoader, info)
 	if err != nil {
 		structuredLogger.Error("Failed to create MCP server", "error", err)
 		os.Exit(1)
 	}
 
+	// Run the smoke check instead of serving requests
+	if *selfTest {
+		report := mcpServer.SelfTest(context.Background())
+		_, _ = fmt.Fprintln(os.Stdout, report.String())
+		_ = auditLogger.Close()
+		if !report.Passed() {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Register MCP tools
 	if err := mcpServer.RegisterTools(); err != nil {
 		structuredLogger.Error("Failed to register MCP tools", "error", err)
 		os.Exit(1)
 	}
 
-	// Start server directly (following official MCP SDK pattern)
+	// Register MCP resources
+	if err := mcpServer.RegisterResources(); err != nil {
+		structuredLogger.Error("Failed to register MCP resources", "error", err)
+		os.Exit(1)
+	}
+
 	ctx := context.Background()
-	if err := mcpServer.Start(ctx); err != nil {
+
+	// Log the effective setup of the server, including the standards discovered at startup
+	mcpServer.LogStartupDiagnostics(ctx)
+
+	// Fail fast when standards are required but none are available
+	if err := mcpServer.CheckStandards(ctx); err != nil {
+		structuredLogger.Error("Standards check failed", "error", err)
+		os.Exit(1)
+	}
+
+	// Start server directly (following official MCP SDK pattern)
+	err = mcpServer.Start(ctx)
+
+	// Close the audit file once the server stopped serving requests
+	if closeErr := auditLogger.Close(); closeErr != nil {
+		structuredLogger.Error("Failed to close audit logger", "error", closeErr)
+	}
+
+	if err != nil {
 		structuredLogger.Error("MCP server failed", "error", err)
 		os.Exit(1)
 	}
 }
+
+// newStandardLoader creates the standard loader for the configured source.
+func newStandardLoader(cfg *config.Config) (server.StandardLoader, error) {
+	switch cfg.GetSource() {
+	case config.SourceHTTP:
+		return standards.NewHTTPStandardLoader(cfg.GetSourceURL())
+	case config.SourceArchive:
+		return standards.NewArchiveStandardLoader(cfg.GetSourcePath())
+	case config.SourceFile:
+		if cfg.GetSingleFile() != "" {
+			return standards.NewSingleFileStandardLoader()
+		}
+		return standards.NewFileStandardLoader(cfg.GetFolder()), nil
+	default:
+		return standards.NewFileStandardLoader(cfg.GetFolder()), nil
+	}
+}
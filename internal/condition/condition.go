@@ -0,0 +1,292 @@
+// Package condition evaluates the boolean expressions of the `when` frontmatter field against a request context.
+//
+// The grammar is deliberately small, so that evaluating an expression cannot do anything but compare strings:
+//
+//	expression = or
+//	or         = and { "||" and }
+//	and        = unary { "&&" unary }
+//	unary      = "!" unary | "(" expression ")" | key [ ( "==" | "!=" ) value ]
+//	value      = word | "'" text "'" | '"' text '"'
+//
+// A comparison is true when the context value of the key equals the value, compared case-insensitively.
+// A missing key has an empty value. A bare key is true when its context value is neither empty nor "false".
+package condition
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// maxNesting is the maximum nesting depth of parentheses and negations of an expression.
+const maxNesting = 32
+
+// Expression is a parsed condition.
+type Expression struct {
+	root node
+}
+
+// node is a node of the expression tree.
+type node interface {
+	eval(context map[string]string) bool
+}
+
+// Parse parses a condition expression.
+func Parse(expression string) (*Expression, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("empty condition")
+	}
+
+	p := &parser{tokens: tokens, pos: 0, depth: 0}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q in condition", p.tokens[p.pos].text)
+	}
+
+	return &Expression{root: root}, nil
+}
+
+// Eval reports whether the expression holds in the context.
+func (e *Expression) Eval(context map[string]string) bool {
+	return e.root.eval(context)
+}
+
+// Matches parses the expression and evaluates it in the context.
+func Matches(expression string, context map[string]string) (bool, error) {
+	parsed, err := Parse(expression)
+	if err != nil {
+		return false, err
+	}
+
+	return parsed.Eval(context), nil
+}
+
+// tokenKind is the kind of a lexical token.
+type tokenKind int
+
+const (
+	// tokenWord is a key or an unquoted value.
+	tokenWord tokenKind = iota
+	// tokenString is a quoted value.
+	tokenString
+	// tokenOperator is one of == != && || ! ( ).
+	tokenOperator
+)
+
+// token is a lexical token of an expression.
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// tokenize splits an expression into tokens.
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c)})
+			i++
+		case strings.HasPrefix(expression[i:], "==") || strings.HasPrefix(expression[i:], "!=") ||
+			strings.HasPrefix(expression[i:], "&&") || strings.HasPrefix(expression[i:], "||"):
+			tokens = append(tokens, token{kind: tokenOperator, text: expression[i : i+2]})
+			i += 2
+		case c == '!':
+			tokens = append(tokens, token{kind: tokenOperator, text: "!"})
+			i++
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(expression[i+1:], c)
+			if end < 0 {
+				return nil, errors.New("unterminated string in condition")
+			}
+			tokens = append(tokens, token{kind: tokenString, text: expression[i+1 : i+1+end]})
+			i += end + 2
+		case isWordChar(c):
+			start := i
+			for i < len(expression) && isWordChar(expression[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: expression[start:i]})
+		default:
+			return nil, fmt.Errorf("unexpected character %q in condition", c)
+		}
+	}
+
+	return tokens, nil
+}
+
+// isWordChar reports whether the character can be part of a key or an unquoted value.
+func isWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '_' || c == '-' || c == '.' || c == '/'
+}
+
+// parser is a recursive descent parser of expression tokens.
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+// parseOr parses a disjunction.
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptOperator("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseAnd parses a conjunction.
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptOperator("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseUnary parses a negation, a parenthesized expression, a comparison or a bare key.
+func (p *parser) parseUnary() (node, error) {
+	if p.depth >= maxNesting {
+		return nil, fmt.Errorf("condition nested deeper than %d levels", maxNesting)
+	}
+
+	if p.acceptOperator("!") {
+		p.depth++
+		operand, err := p.parseUnary()
+		p.depth--
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+
+	if p.acceptOperator("(") {
+		p.depth++
+		inner, err := p.parseOr()
+		p.depth--
+		if err != nil {
+			return nil, err
+		}
+		if !p.acceptOperator(")") {
+			return nil, errors.New("missing ) in condition")
+		}
+		return inner, nil
+	}
+
+	key, ok := p.next()
+	if !ok {
+		return nil, errors.New("unexpected end of condition")
+	}
+	if key.kind != tokenWord {
+		return nil, fmt.Errorf("expected a key in condition, got %q", key.text)
+	}
+
+	for _, operator := range []string{"==", "!="} {
+		if !p.acceptOperator(operator) {
+			continue
+		}
+		value, ok := p.next()
+		if !ok || value.kind == tokenOperator {
+			return nil, fmt.Errorf("expected a value after %s %s in condition", key.text, operator)
+		}
+		return compareNode{key: key.text, value: value.text, negate: operator == "!="}, nil
+	}
+
+	return keyNode{key: key.text}, nil
+}
+
+// next consumes the next token.
+func (p *parser) next() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{kind: tokenWord, text: ""}, false
+	}
+	p.pos++
+	return p.tokens[p.pos-1], true
+}
+
+// acceptOperator consumes the next token if it is the operator.
+func (p *parser) acceptOperator(operator string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == tokenOperator && p.tokens[p.pos].text == operator {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// orNode is true when either operand is true.
+type orNode struct {
+	left, right node
+}
+
+func (n orNode) eval(context map[string]string) bool {
+	return n.left.eval(context) || n.right.eval(context)
+}
+
+// andNode is true when both operands are true.
+type andNode struct {
+	left, right node
+}
+
+func (n andNode) eval(context map[string]string) bool {
+	return n.left.eval(context) && n.right.eval(context)
+}
+
+// notNode negates its operand.
+type notNode struct {
+	operand node
+}
+
+func (n notNode) eval(context map[string]string) bool {
+	return !n.operand.eval(context)
+}
+
+// compareNode compares the context value of a key with a value.
+type compareNode struct {
+	key    string
+	value  string
+	negate bool
+}
+
+func (n compareNode) eval(context map[string]string) bool {
+	return strings.EqualFold(strings.TrimSpace(context[n.key]), n.value) != n.negate
+}
+
+// keyNode is true when the context value of a key is set and not false.
+type keyNode struct {
+	key string
+}
+
+func (n keyNode) eval(context map[string]string) bool {
+	value := strings.TrimSpace(context[n.key])
+	return value != "" && !strings.EqualFold(value, "false")
+}
@@ -0,0 +1,73 @@
+package condition
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatches(t *testing.T) {
+	goContext := map[string]string{"language": "go", "framework": "gin", "ci": "true"}
+
+	tests := []struct {
+		name       string
+		expression string
+		context    map[string]string
+		expected   bool
+	}{
+		{"equal", "language == go", goContext, true},
+		{"equal case-insensitive", "language == Go", goContext, true},
+		{"not equal", "language == python", goContext, false},
+		{"inequality", "language != python", goContext, true},
+		{"quoted value", `framework == "gin"`, goContext, true},
+		{"single quoted value with spaces", "team == 'core platform'", map[string]string{"team": "core platform"}, true},
+		{"and", "language == go && framework == gin", goContext, true},
+		{"and false", "language == go && framework == echo", goContext, false},
+		{"or", "language == python || framework == gin", goContext, true},
+		{"and binds tighter than or", "language == python && ci || framework == gin", goContext, true},
+		{"parentheses", "language == python && (ci || framework == gin)", goContext, false},
+		{"negation", "!(language == python)", goContext, true},
+		{"bare key set", "ci", goContext, true},
+		{"bare key false", "ci", map[string]string{"ci": "false"}, false},
+		{"missing key equal", "language == go", map[string]string{}, false},
+		{"missing key not equal", "language != go", map[string]string{}, true},
+		{"missing bare key", "ci", nil, false},
+		{"missing context", "language == go || !ci", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Matches(tt.expression, tt.context)
+			if err != nil {
+				t.Fatalf("Matches(%q) error = %v", tt.expression, err)
+			}
+			if got != tt.expected {
+				t.Errorf("Matches(%q) = %v, expected %v", tt.expression, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{"empty", "  "},
+		{"missing value", "language =="},
+		{"operator as value", "language == &&"},
+		{"dangling and", "language == go &&"},
+		{"unbalanced parentheses", "(language == go"},
+		{"trailing token", "language == go go"},
+		{"unterminated string", `language == "go`},
+		{"unknown character", "language = go"},
+		{"too deep", strings.Repeat("!", maxNesting+1) + "ci"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.expression); err == nil {
+				t.Errorf("Parse(%q) expected an error", tt.expression)
+			}
+		})
+	}
+}
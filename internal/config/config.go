@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 )
@@ -14,24 +15,136 @@ const (
 	defaultMaxStandards = 100
 	// defaultMaxStandardSize is the default maximum size of a single standard file in bytes.
 	defaultMaxStandardSize = 10240
+	// defaultMaxGetNames is the default maximum number of names accepted by a single get_standards call.
+	defaultMaxGetNames = 100
+	// defaultMaxDepth is the default number of directory levels scanned below the standards folder.
+	defaultMaxDepth = 10
+	// defaultCharsPerToken is the default number of characters estimated as one token.
+	defaultCharsPerToken = 4
+	// defaultLanguage is the default language variant served when no language is requested.
+	defaultLanguage = "en"
 )
 
 // Config holds the configuration for the agent-standards-mcp server.
 type Config struct {
-	LogLevel        string `env:"AGENT_STANDARDS_MCP_LOG_LEVEL" envDefault:"ERROR"`
-	Folder          string `env:"AGENT_STANDARDS_MCP_FOLDER" envDefault:"~/agent-standards"`
-	MaxStandards    int    `env:"AGENT_STANDARDS_MCP_MAX_STANDARDS" envDefault:"100"`
-	MaxStandardSize int    `env:"AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE" envDefault:"10240"`
+	LogLevel         string        `env:"AGENT_STANDARDS_MCP_LOG_LEVEL" envDefault:"ERROR"`
+	Folder           string        `env:"AGENT_STANDARDS_MCP_FOLDER" envDefault:"~/agent-standards"`
+	MaxStandards     int           `env:"AGENT_STANDARDS_MCP_MAX_STANDARDS" envDefault:"100"`
+	MaxStandardSize  ByteSize      `env:"AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE" envDefault:"10240"`
+	Recursive        bool          `env:"AGENT_STANDARDS_MCP_RECURSIVE" envDefault:"false"`
+	ClientLogs       string        `env:"AGENT_STANDARDS_MCP_CLIENT_LOGS" envDefault:""`
+	NameStrategy     string        `env:"AGENT_STANDARDS_MCP_NAME_STRATEGY" envDefault:"filename"`
+	DefaultLanguage  string        `env:"AGENT_STANDARDS_MCP_DEFAULT_LANGUAGE" envDefault:"en"`
+	ConfigTool       bool          `env:"AGENT_STANDARDS_MCP_ENABLE_CONFIG_TOOL" envDefault:"false"`
+	Annotations      bool          `env:"AGENT_STANDARDS_MCP_CONTENT_ANNOTATIONS" envDefault:"false"`
+	ListLimit        int           `env:"AGENT_STANDARDS_MCP_DEFAULT_LIST_LIMIT" envDefault:"0"`
+	TemplateVars     string        `env:"AGENT_STANDARDS_MCP_TEMPLATE_VARS" envDefault:""`
+	Allowlist        string        `env:"AGENT_STANDARDS_MCP_ALLOWLIST" envDefault:""`
+	NoResultsPrompt  string        `env:"AGENT_STANDARDS_MCP_NO_RESULTS_PROMPT" envDefault:""`
+	Source           string        `env:"AGENT_STANDARDS_MCP_SOURCE" envDefault:"file"`
+	SourceURL        string        `env:"AGENT_STANDARDS_MCP_SOURCE_URL" envDefault:""`
+	SourcePath       string        `env:"AGENT_STANDARDS_MCP_SOURCE_PATH" envDefault:""`
+	ListDescription  string        `env:"AGENT_STANDARDS_MCP_LIST_DESC" envDefault:""`
+	GetDescription   string        `env:"AGENT_STANDARDS_MCP_GET_DESC" envDefault:""`
+	RequireStandards bool          `env:"AGENT_STANDARDS_MCP_REQUIRE_STANDARDS" envDefault:"false"`
+	MaxGetNames      int           `env:"AGENT_STANDARDS_MCP_MAX_GET_NAMES" envDefault:"100"`
+	Prewarm          bool          `env:"AGENT_STANDARDS_MCP_PREWARM" envDefault:"false"`
+	AuditFormat      string        `env:"AGENT_STANDARDS_MCP_AUDIT_FORMAT" envDefault:"text"`
+	StripComments    bool          `env:"AGENT_STANDARDS_MCP_STRIP_HTML_COMMENTS" envDefault:"false"`
+	DemoteHeadings   bool          `env:"AGENT_STANDARDS_MCP_DEMOTE_HEADINGS" envDefault:"false"`
+	ContentPrefix    string        `env:"AGENT_STANDARDS_MCP_CONTENT_PREFIX" envDefault:""`
+	ContentSuffix    string        `env:"AGENT_STANDARDS_MCP_CONTENT_SUFFIX" envDefault:""`
+	Strict           bool          `env:"AGENT_STANDARDS_MCP_STRICT" envDefault:"true"`
+	LanguageVariants bool          `env:"AGENT_STANDARDS_MCP_LANGUAGE_VARIANTS" envDefault:"false"`
+	NormalizeEOL     bool          `env:"AGENT_STANDARDS_MCP_NORMALIZE_NEWLINES" envDefault:"true"`
+	EchoInput        bool          `env:"AGENT_STANDARDS_MCP_ECHO_INPUT_ON_ERROR" envDefault:"false"`
+	SingleFile       string        `env:"AGENT_STANDARDS_MCP_SINGLE_FILE" envDefault:""`
+	MaxResponseSize  ByteSize      `env:"AGENT_STANDARDS_MCP_MAX_RESPONSE_SIZE" envDefault:"0"`
+	TruncateContent  bool          `env:"AGENT_STANDARDS_MCP_TRUNCATE_CONTENT" envDefault:"false"`
+	BudgetUnit       string        `env:"AGENT_STANDARDS_MCP_BUDGET_UNIT" envDefault:"bytes"`
+	CharsPerToken    int           `env:"AGENT_STANDARDS_MCP_CHARS_PER_TOKEN" envDefault:"4"`
+	MaxDepth         int           `env:"AGENT_STANDARDS_MCP_MAX_DEPTH" envDefault:"10"`
+	ReadRetries      int           `env:"AGENT_STANDARDS_MCP_READ_RETRIES" envDefault:"0"`
+	KeepWhitespace   bool          `env:"AGENT_STANDARDS_MCP_PRESERVE_WHITESPACE" envDefault:"false"`
+	DecryptKey       string        `env:"AGENT_STANDARDS_MCP_DECRYPT_KEY" envDefault:""`
+	DefaultDesc      string        `env:"AGENT_STANDARDS_MCP_DEFAULT_DESCRIPTION" envDefault:""`
+	RequireFileLogs  bool          `env:"AGENT_STANDARDS_MCP_REQUIRE_FILE_LOGS" envDefault:"false"`
+	EmptyDescOK      bool          `env:"AGENT_STANDARDS_MCP_EMPTY_DESCRIPTION_OK" envDefault:"false"`
+	HeadingTitle     bool          `env:"AGENT_STANDARDS_MCP_TITLE_FROM_HEADING" envDefault:"false"`
+	StripHeading     bool          `env:"AGENT_STANDARDS_MCP_STRIP_TITLE_HEADING" envDefault:"false"`
+	TrailingNewline  bool          `env:"AGENT_STANDARDS_MCP_TRAILING_NEWLINE" envDefault:"false"`
+	CacheTTL         time.Duration `env:"AGENT_STANDARDS_MCP_CACHE_TTL" envDefault:"0s"`
+	SharedScanCache  bool          `env:"AGENT_STANDARDS_MCP_SHARED_SCAN_CACHE" envDefault:"false"`
+	RequestDeadline  time.Duration `env:"AGENT_STANDARDS_MCP_REQUEST_DEADLINE" envDefault:"0s"`
+	StatsInterval    time.Duration `env:"AGENT_STANDARDS_MCP_CACHE_STATS_INTERVAL" envDefault:"0s"`
+	DisplayNames     string        `env:"AGENT_STANDARDS_MCP_DISPLAY_NAMES" envDefault:"canonical"`
+	MaxInputBytes    ByteSize      `env:"AGENT_STANDARDS_MCP_MAX_INPUT_BYTES" envDefault:"0"`
+	EmptyGet         string        `env:"AGENT_STANDARDS_MCP_EMPTY_GET" envDefault:"not-found"`
+}
+
+// Default returns the configuration used when no environment variables are set.
+// The returned configuration is not validated.
+func Default() *Config {
+	return &Config{
+		LogLevel:         "ERROR",
+		Folder:           "~/agent-standards",
+		MaxStandards:     defaultMaxStandards,
+		MaxStandardSize:  defaultMaxStandardSize,
+		Recursive:        false,
+		ClientLogs:       "",
+		NameStrategy:     string(NameStrategyFilename),
+		DefaultLanguage:  defaultLanguage,
+		ConfigTool:       false,
+		Annotations:      false,
+		ListLimit:        0,
+		TemplateVars:     "",
+		Allowlist:        "",
+		NoResultsPrompt:  "",
+		Source:           string(SourceFile),
+		SourceURL:        "",
+		SourcePath:       "",
+		ListDescription:  "",
+		GetDescription:   "",
+		RequireStandards: false,
+		MaxGetNames:      defaultMaxGetNames,
+		Prewarm:          false,
+		AuditFormat:      string(AuditFormatText),
+		StripComments:    false,
+		DemoteHeadings:   false,
+		ContentPrefix:    "",
+		ContentSuffix:    "",
+		Strict:           true,
+		LanguageVariants: false,
+		NormalizeEOL:     true,
+		EchoInput:        false,
+		SingleFile:       "",
+		MaxResponseSize:  0,
+		TruncateContent:  false,
+		BudgetUnit:       string(BudgetUnitBytes),
+		CharsPerToken:    defaultCharsPerToken,
+		MaxDepth:         defaultMaxDepth,
+		ReadRetries:      0,
+		KeepWhitespace:   false,
+		DecryptKey:       "",
+		DefaultDesc:      "",
+		RequireFileLogs:  false,
+		EmptyDescOK:      false,
+		HeadingTitle:     false,
+		StripHeading:     false,
+		TrailingNewline:  false,
+		CacheTTL:         0,
+		SharedScanCache:  false,
+		RequestDeadline:  0,
+		StatsInterval:    0,
+		DisplayNames:     string(DisplayNamesCanonical),
+		MaxInputBytes:    0,
+		EmptyGet:         string(EmptyGetNotFound),
+	}
 }
 
 // Load loads configuration from environment variables and validates it.
 func Load() (*Config, error) {
-	cfg := &Config{
-		LogLevel:        "ERROR",
-		Folder:          "~/agent-standards",
-		MaxStandards:    defaultMaxStandards,
-		MaxStandardSize: defaultMaxStandardSize,
-	}
+	cfg := Default()
 
 	if err := env.Parse(cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse environment variables: %w", err)
@@ -50,14 +163,59 @@ func (c *Config) Validate() error {
 		return err
 	}
 
-	if err := c.validateFolder(); err != nil {
+	if err := c.validateSource(); err != nil {
 		return err
 	}
 
+	// The standards folder is only used by the file source without a single combined file
+	if c.GetSource() == SourceFile && c.SingleFile == "" {
+		if err := c.validateFolder(); err != nil {
+			return err
+		}
+	}
+
 	if err := c.validateLimits(); err != nil {
 		return err
 	}
 
+	if err := c.validateClientLogs(); err != nil {
+		return err
+	}
+
+	if err := validateNameStrategy(c.NameStrategy); err != nil {
+		return err
+	}
+
+	if err := validateLanguage(c.DefaultLanguage); err != nil {
+		return fmt.Errorf("invalid default language: %w", err)
+	}
+
+	if err := validateAuditFormat(string(c.GetAuditFormat())); err != nil {
+		return err
+	}
+
+	if err := validateDisplayNames(string(c.GetDisplayNames())); err != nil {
+		return err
+	}
+
+	if err := validateEmptyGet(string(c.GetEmptyGet())); err != nil {
+		return err
+	}
+
+	if err := validateBudgetUnit(string(c.GetBudgetUnit())); err != nil {
+		return err
+	}
+
+	if err := validateAllowlist(c.GetAllowlist()); err != nil {
+		return err
+	}
+
+	if c.DecryptKey != "" {
+		if _, err := ParseDecryptKey(c.DecryptKey); err != nil {
+			return fmt.Errorf("invalid AGENT_STANDARDS_MCP_DECRYPT_KEY: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -77,27 +235,101 @@ func (c *Config) validateFolder() error {
 	}
 
 	// Expand ~ to user home directory and validate
-	expandedPath, err := expandPath(c.Folder)
+	resolvedPath, err := ResolveFolder(c.Folder)
 	if err != nil {
-		return fmt.Errorf("failed to expand folder path: %w", err)
+		return fmt.Errorf("failed to resolve folder path: %w", err)
 	}
 
-	// Store the expanded path back to the config
-	c.Folder = expandedPath
+	// Store the resolved path back to the config
+	c.Folder = resolvedPath
 
 	return validateDirectoryPath(c.Folder)
 }
 
+// validateSource validates the standards source and its URL.
+func (c *Config) validateSource() error {
+	if err := validateSource(string(c.GetSource())); err != nil {
+		return err
+	}
+
+	switch c.GetSource() {
+	case SourceHTTP:
+		return validateSourceURL(c.SourceURL)
+	case SourceArchive:
+		return validateSourcePath(c.SourcePath)
+	case SourceFile:
+		if c.SingleFile != "" {
+			return validateSingleFile(c.SingleFile)
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
 // validateLimits validates numeric configuration limits.
 func (c *Config) validateLimits() error {
 	if err := validatePositiveInt(c.MaxStandards, "MaxStandards"); err != nil {
 		return err
 	}
 
-	if err := validatePositiveInt(c.MaxStandardSize, "MaxStandardSize"); err != nil {
+	if err := validatePositiveInt(int(c.MaxStandardSize), "MaxStandardSize"); err != nil {
+		return err
+	}
+
+	if err := validateNonNegativeInt(c.ListLimit, "DefaultListLimit"); err != nil {
+		return err
+	}
+
+	if err := validateNonNegativeInt(c.MaxGetNames, "MaxGetNames"); err != nil {
+		return err
+	}
+
+	if err := validateNonNegativeInt(int(c.MaxResponseSize), "MaxResponseSize"); err != nil {
 		return err
 	}
 
+	if err := validateNonNegativeInt(c.CharsPerToken, "CharsPerToken"); err != nil {
+		return err
+	}
+
+	if err := validateNonNegativeInt(c.MaxDepth, "MaxDepth"); err != nil {
+		return err
+	}
+
+	if err := validateNonNegativeInt(c.ReadRetries, "ReadRetries"); err != nil {
+		return err
+	}
+
+	if err := validateNonNegativeInt(int(c.MaxInputBytes), "MaxInputBytes"); err != nil {
+		return err
+	}
+
+	if c.CacheTTL < 0 {
+		return fmt.Errorf("CacheTTL must not be negative, got: %s", c.CacheTTL)
+	}
+
+	if c.RequestDeadline < 0 {
+		return fmt.Errorf("RequestDeadline must not be negative, got: %s", c.RequestDeadline)
+	}
+
+	if c.StatsInterval < 0 {
+		return fmt.Errorf("StatsInterval must not be negative, got: %s", c.StatsInterval)
+	}
+
+	return nil
+}
+
+// validateClientLogs validates the minimum log level forwarded to MCP clients.
+func (c *Config) validateClientLogs() error {
+	if c.ClientLogs == "" {
+		return nil
+	}
+
+	if err := validateLogLevel(c.ClientLogs); err != nil {
+		return fmt.Errorf("invalid client logs level: %w", err)
+	}
+
 	return nil
 }
 
@@ -123,5 +355,311 @@ func (c *Config) GetMaxStandards() int {
 
 // GetMaxStandardSize returns the maximum size of a single standard file in bytes.
 func (c *Config) GetMaxStandardSize() int {
-	return c.MaxStandardSize
+	return int(c.MaxStandardSize)
+}
+
+// GetMaxDepth returns the number of directory levels below the standards folder scanned recursively,
+// 0 for unlimited.
+func (c *Config) GetMaxDepth() int {
+	return c.MaxDepth
+}
+
+// GetReadRetries returns the number of times a standard file read failing with a transient error is retried.
+func (c *Config) GetReadRetries() int {
+	return c.ReadRetries
+}
+
+// IsTitleFromHeadingEnabled returns true if standards without a frontmatter title take the first "# " heading
+// of their content as the title.
+func (c *Config) IsTitleFromHeadingEnabled() bool {
+	return c.HeadingTitle
+}
+
+// IsStripTitleHeadingEnabled returns true if the heading taken as the title is removed from the content.
+func (c *Config) IsStripTitleHeadingEnabled() bool {
+	return c.StripHeading
+}
+
+// IsEmptyDescriptionAllowed returns true if an empty or whitespace-only frontmatter description is treated
+// as no description instead of failing the standard.
+func (c *Config) IsEmptyDescriptionAllowed() bool {
+	return c.EmptyDescOK
+}
+
+// GetDisplayNames returns the normalized transform of standard names displayed by list_standards.
+// An empty value means canonical names.
+func (c *Config) GetDisplayNames() DisplayNames {
+	if c.DisplayNames == "" {
+		return DisplayNamesCanonical
+	}
+	return DisplayNames(strings.ToLower(c.DisplayNames))
+}
+
+// GetRequestDeadline returns how long a tool call may run before it fails with a deadline error, 0 for no limit.
+func (c *Config) GetRequestDeadline() time.Duration {
+	return c.RequestDeadline
+}
+
+// IsSharedScanCacheEnabled reports whether scans of the standards folder are cached for all loaders of the process.
+func (c *Config) IsSharedScanCacheEnabled() bool {
+	return c.SharedScanCache
+}
+
+// GetEmptyGet returns the normalized response of get_standards to an empty standard_names array.
+// An empty value means the not-found response.
+func (c *Config) GetEmptyGet() EmptyGet {
+	if c.EmptyGet == "" {
+		return EmptyGetNotFound
+	}
+	return EmptyGet(strings.ToLower(c.EmptyGet))
+}
+
+// GetCacheTTL returns how long a scan of the standards folder is reused before the folder is scanned again,
+// 0 to scan on every request.
+func (c *Config) GetCacheTTL() time.Duration {
+	return c.CacheTTL
+}
+
+// GetCacheStatsInterval returns how often the statistics of the standards cache are logged, 0 to never log them.
+func (c *Config) GetCacheStatsInterval() time.Duration {
+	return c.StatsInterval
+}
+
+// IsTrailingNewlineEnabled returns true if tool results listing or returning standards end with a single
+// newline instead of none.
+func (c *Config) IsTrailingNewlineEnabled() bool {
+	return c.TrailingNewline
+}
+
+// IsPreserveWhitespaceEnabled returns true if whitespace surrounding standard content is kept,
+// except for a single leading and trailing newline.
+func (c *Config) IsPreserveWhitespaceEnabled() bool {
+	return c.KeepWhitespace
+}
+
+// IsDecryptionEnabled returns true if a key to decrypt encrypted standard files is configured.
+func (c *Config) IsDecryptionEnabled() bool {
+	return c.DecryptKey != ""
+}
+
+// IsRecursive returns true if the standards folder is scanned recursively.
+func (c *Config) IsRecursive() bool {
+	return c.Recursive
+}
+
+// IsClientLoggingEnabled returns true if logs are forwarded to connected MCP clients.
+func (c *Config) IsClientLoggingEnabled() bool {
+	return c.ClientLogs != "" && c.GetClientLogLevel() != LogLevelNone
+}
+
+// GetClientLogLevel returns the normalized minimum log level forwarded to MCP clients.
+func (c *Config) GetClientLogLevel() LogLevel {
+	return LogLevel(strings.ToUpper(c.ClientLogs))
+}
+
+// GetNameStrategy returns the normalized strategy used to derive standard names.
+func (c *Config) GetNameStrategy() NameStrategy {
+	return NameStrategy(strings.ToLower(c.NameStrategy))
+}
+
+// GetDefaultLanguage returns the normalized language variant served when no language is requested.
+func (c *Config) GetDefaultLanguage() string {
+	return strings.ToLower(c.DefaultLanguage)
+}
+
+// IsConfigToolEnabled returns true if the get_config tool is exposed to MCP clients.
+func (c *Config) IsConfigToolEnabled() bool {
+	return c.ConfigTool
+}
+
+// GetSingleFile returns the path of the combined standards file, empty if standards are read from the folder.
+func (c *Config) GetSingleFile() string {
+	return c.SingleFile
+}
+
+// IsEchoInputOnErrorEnabled returns true if failed tool calls echo the received, redacted input
+// in their structured content.
+func (c *Config) IsEchoInputOnErrorEnabled() bool {
+	return c.EchoInput
+}
+
+// IsContentAnnotationsEnabled returns true if get_standards returns each standard
+// as a separate content block annotated with its priority.
+func (c *Config) IsContentAnnotationsEnabled() bool {
+	return c.Annotations
+}
+
+// GetMaxResponseSize returns the maximum total size of standard contents returned by get_standards
+// in the budget unit, 0 for unlimited.
+func (c *Config) GetMaxResponseSize() int {
+	return int(c.MaxResponseSize)
+}
+
+// GetBudgetUnit returns the normalized unit of the response size limit. An empty value means bytes.
+func (c *Config) GetBudgetUnit() BudgetUnit {
+	if c.BudgetUnit == "" {
+		return BudgetUnitBytes
+	}
+	return BudgetUnit(strings.ToLower(c.BudgetUnit))
+}
+
+// GetCharsPerToken returns the number of characters counted as one token when the budget unit is tokens.
+// 0 means the default of 4.
+func (c *Config) GetCharsPerToken() int {
+	if c.CharsPerToken <= 0 {
+		return defaultCharsPerToken
+	}
+	return c.CharsPerToken
+}
+
+// GetMaxInputBytes returns the maximum size of the serialized input of a tool call in bytes, 0 for unlimited.
+func (c *Config) GetMaxInputBytes() int {
+	return int(c.MaxInputBytes)
+}
+
+// IsTruncateContentEnabled returns true if a standard exceeding the response size limit is truncated
+// to fit instead of being omitted.
+func (c *Config) IsTruncateContentEnabled() bool {
+	return c.TruncateContent
+}
+
+// GetDefaultListLimit returns the number of standards listed when no limit is requested, 0 for unlimited.
+func (c *Config) GetDefaultListLimit() int {
+	return c.ListLimit
+}
+
+// GetMaxGetNames returns the maximum number of names accepted by a single get_standards call, 0 for unlimited.
+func (c *Config) GetMaxGetNames() int {
+	return c.MaxGetNames
+}
+
+// IsFileLogRequired returns true if the server must not start when log files cannot be written.
+// Otherwise an unwritable log directory falls back to logging to stderr only.
+func (c *Config) IsFileLogRequired() bool {
+	return c.RequireFileLogs
+}
+
+// IsStandardsRequired returns true if the server must not start without any standards.
+func (c *Config) IsStandardsRequired() bool {
+	return c.RequireStandards
+}
+
+// GetAuditFormat returns the normalized format of the audit events.
+// An empty format means text.
+func (c *Config) GetAuditFormat() AuditFormat {
+	if c.AuditFormat == "" {
+		return AuditFormatText
+	}
+	return AuditFormat(strings.ToLower(c.AuditFormat))
+}
+
+// IsStripCommentsEnabled returns true if HTML comments are removed from standard content.
+func (c *Config) IsStripCommentsEnabled() bool {
+	return c.StripComments
+}
+
+// IsNormalizeNewlinesEnabled returns true if CRLF and lone CR line endings of standard content are turned into LF.
+func (c *Config) IsNormalizeNewlinesEnabled() bool {
+	return c.NormalizeEOL
+}
+
+// IsDemoteHeadingsEnabled returns true if top-level headings of standard content are demoted by one level.
+func (c *Config) IsDemoteHeadingsEnabled() bool {
+	return c.DemoteHeadings
+}
+
+// GetContentPrefix returns the template written before the content of each standard in get_standards.
+// An empty prefix and suffix mean the built-in heading and code fence are used.
+func (c *Config) GetContentPrefix() string {
+	return c.ContentPrefix
+}
+
+// GetContentSuffix returns the template written after the content of each standard in get_standards.
+func (c *Config) GetContentSuffix() string {
+	return c.ContentSuffix
+}
+
+// IsStrict returns true if get_standards fails when a requested standard exceeds the maximum size.
+// Otherwise oversized standards are skipped with a warning and the other standards are returned.
+func (c *Config) IsStrict() bool {
+	return c.Strict
+}
+
+// IsLanguageVariantsEnabled returns true if an ISO 639-1 tag before the extension of a standard file name
+// marks a language variant of the standard instead of being part of its name.
+func (c *Config) IsLanguageVariantsEnabled() bool {
+	return c.LanguageVariants
+}
+
+// IsPrewarmEnabled returns true if the standards are listed once at startup to warm the loader cache.
+func (c *Config) IsPrewarmEnabled() bool {
+	return c.Prewarm
+}
+
+// GetTemplateVars returns the environment variables that may be expanded in standard content.
+// An empty result means templating is disabled.
+func (c *Config) GetTemplateVars() []string {
+	var vars []string
+	for name := range strings.SplitSeq(c.TemplateVars, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			vars = append(vars, name)
+		}
+	}
+
+	return vars
+}
+
+// GetAllowlist returns the names and glob patterns of the standards served, empty to serve all standards.
+func (c *Config) GetAllowlist() []string {
+	var patterns []string
+	for pattern := range strings.SplitSeq(c.Allowlist, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	return patterns
+}
+
+// GetDefaultDescription returns the description shown by list_standards for standards without one.
+// An empty result keeps the description empty.
+func (c *Config) GetDefaultDescription() string {
+	return strings.TrimSpace(c.DefaultDesc)
+}
+
+// GetNoResultsPrompt returns the configured guidance for filtered requests matching no standards.
+// An empty result means the built-in guidance is used.
+func (c *Config) GetNoResultsPrompt() string {
+	return strings.TrimSpace(c.NoResultsPrompt)
+}
+
+// GetSource returns the normalized source the standards are loaded from.
+// An empty source means the standards folder.
+func (c *Config) GetSource() Source {
+	if c.Source == "" {
+		return SourceFile
+	}
+	return Source(strings.ToLower(c.Source))
+}
+
+// GetSourceURL returns the URL of the standards index directory used by the http source.
+func (c *Config) GetSourceURL() string {
+	return c.SourceURL
+}
+
+// GetSourcePath returns the path of the standards archive used by the archive source.
+func (c *Config) GetSourcePath() string {
+	return c.SourcePath
+}
+
+// GetListDescription returns the configured description of the list_standards tool.
+// An empty result means the built-in description is used.
+func (c *Config) GetListDescription() string {
+	return strings.TrimSpace(c.ListDescription)
+}
+
+// GetGetDescription returns the configured description of the get_standards tool.
+// An empty result means the built-in description is used.
+func (c *Config) GetGetDescription() string {
+	return strings.TrimSpace(c.GetDescription)
 }
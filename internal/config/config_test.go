@@ -1,9 +1,11 @@
 package config
 
 import (
+	"encoding/base64"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,7 +22,15 @@ func TestLoad_DefaultValues(t *testing.T) {
 	assert.Equal(t, "ERROR", cfg.LogLevel)
 	assert.Contains(t, cfg.Folder, "agent-standards")
 	assert.Equal(t, 100, cfg.MaxStandards)
-	assert.Equal(t, 10240, cfg.MaxStandardSize)
+	assert.Equal(t, 10240, cfg.GetMaxStandardSize())
+	assert.False(t, cfg.Recursive)
+	assert.Equal(t, NameStrategyFilename, cfg.GetNameStrategy())
+	assert.Equal(t, "en", cfg.GetDefaultLanguage())
+	assert.False(t, cfg.IsConfigToolEnabled())
+	assert.False(t, cfg.IsContentAnnotationsEnabled())
+	assert.Equal(t, 0, cfg.GetDefaultListLimit())
+	assert.Empty(t, cfg.GetTemplateVars())
+	assert.Equal(t, 100, cfg.GetMaxGetNames())
 }
 
 func TestLoad_EnvironmentVariables(t *testing.T) {
@@ -32,6 +42,7 @@ func TestLoad_EnvironmentVariables(t *testing.T) {
 	t.Setenv("AGENT_STANDARDS_MCP_FOLDER", "/tmp/custom-standards")
 	t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARDS", "200")
 	t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE", "20480")
+	t.Setenv("AGENT_STANDARDS_MCP_RECURSIVE", "true")
 
 	cfg, err := Load()
 	require.NoError(t, err)
@@ -40,7 +51,8 @@ func TestLoad_EnvironmentVariables(t *testing.T) {
 	assert.Equal(t, "DEBUG", cfg.LogLevel)
 	assert.Equal(t, "/tmp/custom-standards", cfg.Folder)
 	assert.Equal(t, 200, cfg.MaxStandards)
-	assert.Equal(t, 20480, cfg.MaxStandardSize)
+	assert.Equal(t, 20480, cfg.GetMaxStandardSize())
+	assert.True(t, cfg.Recursive)
 }
 
 func TestConfig_ValidateLogLevel(t *testing.T) {
@@ -108,18 +120,90 @@ func TestConfig_ValidateFolder(t *testing.T) {
 	}
 }
 
+func TestLoad_MaxStandardSizeUnits(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    int
+		expectError bool
+	}{
+		{"Kilobytes", "64KB", 64 * 1024, false},
+		{"Megabytes", "1MB", 1024 * 1024, false},
+		{"Plain bytes", "1048576", 1048576, false},
+		{"Lowercase unit with space", "2 kb", 2048, false},
+		{"Unknown unit", "10XB", 0, true},
+		{"Fractional size", "1.5MB", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearEnvVars()
+			t.Setenv("AGENT_STANDARDS_MCP_FOLDER", t.TempDir())
+			t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE", tt.value)
+
+			cfg, err := Load()
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid size")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.GetMaxStandardSize())
+		})
+	}
+}
+
+func TestLoad_CacheTTL(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    time.Duration
+		expectError bool
+	}{
+		{"Disabled by default", "", 0, false},
+		{"Seconds", "30s", 30 * time.Second, false},
+		{"Minutes", "5m", 5 * time.Minute, false},
+		{"Negative", "-1s", 0, true},
+		{"Missing unit", "30", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearEnvVars()
+			t.Setenv("AGENT_STANDARDS_MCP_FOLDER", t.TempDir())
+			t.Setenv("AGENT_STANDARDS_MCP_CACHE_TTL", tt.value)
+
+			cfg, err := Load()
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.GetCacheTTL())
+		})
+	}
+}
+
 func TestConfig_ValidateLimits(t *testing.T) {
 	tests := []struct {
 		name            string
 		maxStandards    int
-		maxStandardSize int
+		maxStandardSize ByteSize
+		listLimit       int
+		maxGetNames     int
 		expectError     bool
 	}{
-		{"Valid limits", 100, 10240, false},
-		{"Zero max standards", 0, 10240, true},
-		{"Negative max standards", -1, 10240, true},
-		{"Zero max standard size", 100, 0, true},
-		{"Negative max standard size", 100, -1, true},
+		{"Valid limits", 100, 10240, 0, 0, false},
+		{"Zero max standards", 0, 10240, 0, 0, true},
+		{"Negative max standards", -1, 10240, 0, 0, true},
+		{"Zero max standard size", 100, 0, 0, 0, true},
+		{"Negative max standard size", 100, -1, 0, 0, true},
+		{"Positive default list limit", 100, 10240, 20, 0, false},
+		{"Negative default list limit", 100, 10240, -1, 0, true},
+		{"Positive max get names", 100, 10240, 0, 50, false},
+		{"Negative max get names", 100, 10240, 0, -1, true},
 	}
 
 	for _, tt := range tests {
@@ -129,6 +213,8 @@ func TestConfig_ValidateLimits(t *testing.T) {
 				Folder:          "/tmp",
 				MaxStandards:    tt.maxStandards,
 				MaxStandardSize: tt.maxStandardSize,
+				ListLimit:       tt.listLimit,
+				MaxGetNames:     tt.maxGetNames,
 			}
 			err := cfg.validateLimits()
 
@@ -141,6 +227,118 @@ func TestConfig_ValidateLimits(t *testing.T) {
 	}
 }
 
+func TestConfig_ValidateClientLogs(t *testing.T) {
+	tests := []struct {
+		name        string
+		clientLogs  string
+		expectError bool
+		enabled     bool
+	}{
+		{"Disabled when empty", "", false, false},
+		{"Disabled with NONE", "NONE", false, false},
+		{"Valid DEBUG", "DEBUG", false, true},
+		{"Valid lowercase", "warn", false, true},
+		{"Invalid level", "VERBOSE", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				LogLevel:        "ERROR",
+				Folder:          "/tmp",
+				MaxStandards:    100,
+				MaxStandardSize: 10240,
+				ClientLogs:      tt.clientLogs,
+			}
+			err := cfg.validateClientLogs()
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.enabled, cfg.IsClientLoggingEnabled())
+		})
+	}
+}
+
+func TestConfig_ValidateNameStrategy(t *testing.T) {
+	tests := []struct {
+		name        string
+		strategy    string
+		expectError bool
+		expected    NameStrategy
+	}{
+		{"Valid filename", "filename", false, NameStrategyFilename},
+		{"Valid first-dot", "first-dot", false, NameStrategyFirstDot},
+		{"Valid uppercase title-slug", "TITLE-SLUG", false, NameStrategyTitleSlug},
+		{"Empty strategy", "", true, ""},
+		{"Invalid strategy", "basename", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				LogLevel:        "ERROR",
+				Folder:          "/tmp",
+				MaxStandards:    100,
+				MaxStandardSize: 10240,
+				NameStrategy:    tt.strategy,
+			}
+			err := cfg.Validate()
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.GetNameStrategy())
+		})
+	}
+}
+
+func TestConfig_ValidateDefaultLanguage(t *testing.T) {
+	tests := []struct {
+		name        string
+		language    string
+		expectError bool
+		expected    string
+	}{
+		{"Valid lowercase", "ru", false, "ru"},
+		{"Valid uppercase", "DE", false, "de"},
+		{"Empty prefers untagged standards", "", false, ""},
+		{"Too long", "eng", true, ""},
+		{"Not letters", "e1", true, ""},
+		{"Not an ISO 639-1 code", "js", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				LogLevel:        "ERROR",
+				Folder:          "/tmp",
+				MaxStandards:    100,
+				MaxStandardSize: 10240,
+				NameStrategy:    "filename",
+				DefaultLanguage: tt.language,
+			}
+			err := cfg.Validate()
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.GetDefaultLanguage())
+		})
+	}
+}
+
+func TestConfig_GetTemplateVars(t *testing.T) {
+	cfg := &Config{TemplateVars: " REGISTRY_URL,,TEAM_NAME , "}
+	assert.Equal(t, []string{"REGISTRY_URL", "TEAM_NAME"}, cfg.GetTemplateVars())
+}
+
 func TestConfig_IsLoggingEnabled(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -215,6 +413,55 @@ func clearEnvVars() {
 		"AGENT_STANDARDS_MCP_FOLDER",
 		"AGENT_STANDARDS_MCP_MAX_STANDARDS",
 		"AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE",
+		"AGENT_STANDARDS_MCP_RECURSIVE",
+		"AGENT_STANDARDS_MCP_CLIENT_LOGS",
+		"AGENT_STANDARDS_MCP_NAME_STRATEGY",
+		"AGENT_STANDARDS_MCP_DEFAULT_LANGUAGE",
+		"AGENT_STANDARDS_MCP_ENABLE_CONFIG_TOOL",
+		"AGENT_STANDARDS_MCP_CONTENT_ANNOTATIONS",
+		"AGENT_STANDARDS_MCP_DEFAULT_LIST_LIMIT",
+		"AGENT_STANDARDS_MCP_TEMPLATE_VARS",
+		"AGENT_STANDARDS_MCP_ALLOWLIST",
+		"AGENT_STANDARDS_MCP_NO_RESULTS_PROMPT",
+		"AGENT_STANDARDS_MCP_SOURCE",
+		"AGENT_STANDARDS_MCP_SOURCE_URL",
+		"AGENT_STANDARDS_MCP_SOURCE_PATH",
+		"AGENT_STANDARDS_MCP_LIST_DESC",
+		"AGENT_STANDARDS_MCP_GET_DESC",
+		"AGENT_STANDARDS_MCP_REQUIRE_STANDARDS",
+		"AGENT_STANDARDS_MCP_MAX_GET_NAMES",
+		"AGENT_STANDARDS_MCP_PREWARM",
+		"AGENT_STANDARDS_MCP_AUDIT_FORMAT",
+		"AGENT_STANDARDS_MCP_STRIP_HTML_COMMENTS",
+		"AGENT_STANDARDS_MCP_DEMOTE_HEADINGS",
+		"AGENT_STANDARDS_MCP_CONTENT_PREFIX",
+		"AGENT_STANDARDS_MCP_CONTENT_SUFFIX",
+		"AGENT_STANDARDS_MCP_STRICT",
+		"AGENT_STANDARDS_MCP_LANGUAGE_VARIANTS",
+		"AGENT_STANDARDS_MCP_NORMALIZE_NEWLINES",
+		"AGENT_STANDARDS_MCP_ECHO_INPUT_ON_ERROR",
+		"AGENT_STANDARDS_MCP_SINGLE_FILE",
+		"AGENT_STANDARDS_MCP_MAX_RESPONSE_SIZE",
+		"AGENT_STANDARDS_MCP_TRUNCATE_CONTENT",
+		"AGENT_STANDARDS_MCP_MAX_DEPTH",
+		"AGENT_STANDARDS_MCP_READ_RETRIES",
+		"AGENT_STANDARDS_MCP_PRESERVE_WHITESPACE",
+		"AGENT_STANDARDS_MCP_DECRYPT_KEY",
+		"AGENT_STANDARDS_MCP_DEFAULT_DESCRIPTION",
+		"AGENT_STANDARDS_MCP_REQUIRE_FILE_LOGS",
+		"AGENT_STANDARDS_MCP_EMPTY_DESCRIPTION_OK",
+		"AGENT_STANDARDS_MCP_TITLE_FROM_HEADING",
+		"AGENT_STANDARDS_MCP_STRIP_TITLE_HEADING",
+		"AGENT_STANDARDS_MCP_TRAILING_NEWLINE",
+		"AGENT_STANDARDS_MCP_CACHE_TTL",
+		"AGENT_STANDARDS_MCP_SHARED_SCAN_CACHE",
+		"AGENT_STANDARDS_MCP_REQUEST_DEADLINE",
+		"AGENT_STANDARDS_MCP_CACHE_STATS_INTERVAL",
+		"AGENT_STANDARDS_MCP_BUDGET_UNIT",
+		"AGENT_STANDARDS_MCP_CHARS_PER_TOKEN",
+		"AGENT_STANDARDS_MCP_DISPLAY_NAMES",
+		"AGENT_STANDARDS_MCP_MAX_INPUT_BYTES",
+		"AGENT_STANDARDS_MCP_EMPTY_GET",
 	}
 
 	for _, envVar := range envVars {
@@ -224,3 +471,282 @@ func clearEnvVars() {
 		}
 	}
 }
+
+func TestConfig_ValidateSource(t *testing.T) {
+	tests := []struct {
+		name        string
+		source      string
+		location    string
+		expectError bool
+		expected    Source
+	}{
+		{"Empty means file", "", "", false, SourceFile},
+		{"Valid file", "file", "", false, SourceFile},
+		{"Valid uppercase http", "HTTP", "https://standards.example.com/team", false, SourceHTTP},
+		{"Http without URL", "http", "", true, ""},
+		{"Http with relative URL", "http", "standards/index", true, ""},
+		{"Http with unsupported scheme", "http", "ftp://standards.example.com", true, ""},
+		{"Invalid source", "s3", "", true, ""},
+		{"Archive without path", "archive", "", true, ""},
+		{"Archive with unsupported format", "archive", "/tmp/standards.rar", true, ""},
+		{"Archive that does not exist", "archive", "/nonexistent/standards.zip", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				LogLevel:        "ERROR",
+				Folder:          "/tmp",
+				MaxStandards:    100,
+				MaxStandardSize: 10240,
+				NameStrategy:    "filename",
+				Source:          tt.source,
+				SourceURL:       tt.location,
+				SourcePath:      tt.location,
+			}
+			err := cfg.Validate()
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.GetSource())
+		})
+	}
+}
+
+func TestConfig_ValidateAuditFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		format      string
+		expectError bool
+		expected    AuditFormat
+	}{
+		{"Empty means text", "", false, AuditFormatText},
+		{"Valid json", "json", false, AuditFormatJSON},
+		{"Valid uppercase csv", "CSV", false, AuditFormatCSV},
+		{"Invalid format", "xml", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				LogLevel:        "ERROR",
+				Folder:          "/tmp",
+				MaxStandards:    100,
+				MaxStandardSize: 10240,
+				NameStrategy:    "filename",
+				AuditFormat:     tt.format,
+			}
+			err := cfg.Validate()
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.GetAuditFormat())
+		})
+	}
+}
+
+func TestConfig_ValidateSingleFile(t *testing.T) {
+	singleFile := filepath.Join(t.TempDir(), "standards.md")
+	require.NoError(t, os.WriteFile(singleFile, []byte("---\nname: a\n---\n"), 0o600))
+
+	tests := []struct {
+		name        string
+		singleFile  string
+		expectError bool
+	}{
+		{"Existing file", singleFile, false},
+		{"Missing file", filepath.Join(t.TempDir(), "missing.md"), true},
+		{"Directory", t.TempDir(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				LogLevel:        "ERROR",
+				Folder:          "/nonexistent/folder",
+				MaxStandards:    100,
+				MaxStandardSize: 10240,
+				NameStrategy:    "filename",
+				SingleFile:      tt.singleFile,
+			}
+			err := cfg.Validate()
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestConfig_ValidateDisplayNames(t *testing.T) {
+	tests := []struct {
+		name         string
+		displayNames string
+		expectError  bool
+		expected     DisplayNames
+	}{
+		{"Empty means canonical", "", false, DisplayNamesCanonical},
+		{"Valid titlecase", "titlecase", false, DisplayNamesTitlecase},
+		{"Valid uppercase titlecase-only", "TITLECASE-ONLY", false, DisplayNamesTitlecaseOnly},
+		{"Invalid transform", "uppercase", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				LogLevel:        "ERROR",
+				Folder:          "/tmp",
+				MaxStandards:    100,
+				MaxStandardSize: 10240,
+				NameStrategy:    "filename",
+				DisplayNames:    tt.displayNames,
+			}
+			err := cfg.Validate()
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.GetDisplayNames())
+		})
+	}
+}
+
+func TestConfig_ValidateBudgetUnit(t *testing.T) {
+	tests := []struct {
+		name        string
+		unit        string
+		expectError bool
+		expected    BudgetUnit
+	}{
+		{"Empty means bytes", "", false, BudgetUnitBytes},
+		{"Valid tokens", "tokens", false, BudgetUnitTokens},
+		{"Valid uppercase bytes", "BYTES", false, BudgetUnitBytes},
+		{"Invalid unit", "words", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				LogLevel:        "ERROR",
+				Folder:          "/tmp",
+				MaxStandards:    100,
+				MaxStandardSize: 10240,
+				NameStrategy:    "filename",
+				BudgetUnit:      tt.unit,
+			}
+			err := cfg.Validate()
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.GetBudgetUnit())
+		})
+	}
+}
+
+func TestConfig_ValidateAllowlist(t *testing.T) {
+	tests := []struct {
+		name        string
+		allowlist   string
+		expectError bool
+		expected    []string
+	}{
+		{"Empty serves all", "", false, nil},
+		{"Names and globs", " go-*, security ,,", false, []string{"go-*", "security"}},
+		{"Invalid pattern", "go-[", true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				LogLevel:        "ERROR",
+				Folder:          "/tmp",
+				MaxStandards:    100,
+				MaxStandardSize: 10240,
+				NameStrategy:    "filename",
+				Allowlist:       tt.allowlist,
+			}
+			err := cfg.Validate()
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.GetAllowlist())
+		})
+	}
+}
+
+func TestParseDecryptKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{"AES-128 key", base64.StdEncoding.EncodeToString(make([]byte, 16)), false},
+		{"AES-256 key", base64.StdEncoding.EncodeToString(make([]byte, 32)), false},
+		{"Wrong length", base64.StdEncoding.EncodeToString(make([]byte, 20)), true},
+		{"Not base64", "not a key!", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDecryptKey(tt.value)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestResolveFolder(t *testing.T) {
+	workDir := t.TempDir()
+	t.Chdir(workDir)
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		folder   string
+		expected string
+	}{
+		{"Relative", "standards", filepath.Join(workDir, "standards")},
+		{"Relative with dots", "./team/../standards/", filepath.Join(workDir, "standards")},
+		{"Absolute", filepath.Join(workDir, "abs"), filepath.Join(workDir, "abs")},
+		{"Home", "~/agent-standards", filepath.Join(home, "agent-standards")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, err := ResolveFolder(tt.folder)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resolved)
+		})
+	}
+
+	// Validation stores the resolved folder, so that it is reused everywhere
+	cfg := &Config{
+		LogLevel:        "ERROR",
+		Folder:          "standards",
+		MaxStandards:    100,
+		MaxStandardSize: 10240,
+		NameStrategy:    "filename",
+	}
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, filepath.Join(workDir, "standards"), cfg.GetFolder())
+}
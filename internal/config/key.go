@@ -0,0 +1,23 @@
+package config
+
+import (
+	"crypto/aes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// ParseDecryptKey parses the base64-encoded AES key of encrypted standards. The key is 16, 24 or 32 bytes long,
+// selecting AES-128, AES-192 or AES-256.
+func ParseDecryptKey(value string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt key must be base64-encoded: %w", err)
+	}
+
+	if _, err := aes.NewCipher(key); err != nil {
+		return nil, fmt.Errorf("decrypt key must be 16, 24 or 32 bytes long: %w", err)
+	}
+
+	return key, nil
+}
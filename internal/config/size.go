@@ -0,0 +1,68 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// kilobyte is the number of bytes in a KB size unit.
+	kilobyte = 1024
+	// megabyte is the number of bytes in a MB size unit.
+	megabyte = 1024 * kilobyte
+	// gigabyte is the number of bytes in a GB size unit.
+	gigabyte = 1024 * megabyte
+)
+
+// ByteSize is a size in bytes that can be configured as a plain byte count or with a unit, e.g. 64KB.
+type ByteSize int
+
+// UnmarshalText parses the size from its configured value.
+func (s *ByteSize) UnmarshalText(text []byte) error {
+	size, err := ParseSize(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = ByteSize(size)
+	return nil
+}
+
+// ParseSize parses a size in bytes from a plain byte count, e.g. 10240, or a whole number followed by
+// a B, KB, MB or GB unit, e.g. 64KB or 1 MB. Units are case-insensitive and use multiples of 1024.
+func ParseSize(value string) (int64, error) {
+	trimmed := strings.ToUpper(strings.TrimSpace(value))
+	if trimmed == "" {
+		return 0, errors.New("size cannot be empty")
+	}
+
+	number, multiplier := trimmed, int64(1)
+	for _, unit := range []struct {
+		suffix     string
+		multiplier int64
+	}{
+		{"GB", gigabyte},
+		{"MB", megabyte},
+		{"KB", kilobyte},
+		{"B", 1},
+	} {
+		if strings.HasSuffix(trimmed, unit.suffix) {
+			number = strings.TrimSpace(strings.TrimSuffix(trimmed, unit.suffix))
+			multiplier = unit.multiplier
+			break
+		}
+	}
+
+	size, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q, expected bytes or a number with a B, KB, MB or GB unit", value)
+	}
+
+	if size > 0 && size > (1<<63-1)/multiplier {
+		return 0, fmt.Errorf("size %q is too large", value)
+	}
+
+	return size * multiplier, nil
+}
@@ -2,8 +2,11 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 )
@@ -24,6 +27,77 @@ const (
 	LogLevelError LogLevel = "ERROR"
 )
 
+// NameStrategy represents how standard names are derived from standard files.
+type NameStrategy string
+
+const (
+	// NameStrategyFilename names a standard by its file name without the final extension.
+	NameStrategyFilename NameStrategy = "filename"
+	// NameStrategyFirstDot names a standard by its file name up to the first dot.
+	NameStrategyFirstDot NameStrategy = "first-dot"
+	// NameStrategyTitleSlug names a standard by the slugified frontmatter title.
+	NameStrategyTitleSlug NameStrategy = "title-slug"
+)
+
+// Source represents where standards are loaded from.
+type Source string
+
+const (
+	// SourceFile loads standards from the standards folder.
+	SourceFile Source = "file"
+	// SourceHTTP loads standards from an index served over HTTP.
+	SourceHTTP Source = "http"
+	// SourceArchive loads standards from a zip or tar archive.
+	SourceArchive Source = "archive"
+)
+
+// AuditFormat represents how audit events are serialized.
+type AuditFormat string
+
+const (
+	// AuditFormatText writes audit events as slog text records.
+	AuditFormatText AuditFormat = "text"
+	// AuditFormatJSON writes audit events as JSON lines.
+	AuditFormatJSON AuditFormat = "json"
+	// AuditFormatCSV writes audit events as CSV rows with a fixed column order.
+	AuditFormatCSV AuditFormat = "csv"
+)
+
+// DisplayNames represents how list_standards displays standard names.
+type DisplayNames string
+
+const (
+	// DisplayNamesCanonical displays the names used to request standards.
+	DisplayNamesCanonical DisplayNames = "canonical"
+	// DisplayNamesTitlecase displays the canonical name followed by the humanized one,
+	// e.g. "error-handling (Error Handling)".
+	DisplayNamesTitlecase DisplayNames = "titlecase"
+	// DisplayNamesTitlecaseOnly displays the humanized name only, e.g. "Error Handling".
+	DisplayNamesTitlecaseOnly DisplayNames = "titlecase-only"
+)
+
+// EmptyGet represents how get_standards responds to an empty standard_names array.
+type EmptyGet string
+
+const (
+	// EmptyGetNotFound responds the same as when none of the requested standards matched.
+	EmptyGetNotFound EmptyGet = "not-found"
+	// EmptyGetMessage responds with a message telling that no standard names were requested.
+	EmptyGetMessage EmptyGet = "message"
+	// EmptyGetEmpty responds with an empty result and an empty standards list in the structured output.
+	EmptyGetEmpty EmptyGet = "empty"
+)
+
+// BudgetUnit represents the unit the response size limit of get_standards is counted in.
+type BudgetUnit string
+
+const (
+	// BudgetUnitBytes counts the response size in bytes.
+	BudgetUnitBytes BudgetUnit = "bytes"
+	// BudgetUnitTokens counts the response size in tokens, estimated from the number of characters.
+	BudgetUnitTokens BudgetUnit = "tokens"
+)
+
 const (
 	// dirPermissions is the default permissions for directory creation.
 	dirPermissions = 0750
@@ -40,6 +114,160 @@ func validateLogLevel(level string) error {
 	}
 }
 
+// validateLanguage checks if the provided language is an ISO 639-1 language tag.
+// An empty language is valid and means untagged standards are preferred.
+func validateLanguage(language string) error {
+	if language == "" {
+		return nil
+	}
+
+	if !IsLanguageTag(strings.ToLower(language)) {
+		return fmt.Errorf("invalid language: %s (must be a two-letter ISO 639-1 language tag)", language)
+	}
+
+	return nil
+}
+
+// validateNameStrategy checks if the provided name strategy is valid.
+func validateNameStrategy(strategy string) error {
+	switch NameStrategy(strings.ToLower(strategy)) {
+	case NameStrategyFilename, NameStrategyFirstDot, NameStrategyTitleSlug:
+		return nil
+	default:
+		return fmt.Errorf("invalid name strategy: %s (must be one of: %s, %s, %s)",
+			strategy, NameStrategyFilename, NameStrategyFirstDot, NameStrategyTitleSlug)
+	}
+}
+
+// validateSource checks if the provided standards source is valid.
+func validateSource(source string) error {
+	switch Source(strings.ToLower(source)) {
+	case SourceFile, SourceHTTP, SourceArchive:
+		return nil
+	default:
+		return fmt.Errorf("invalid source: %s (must be one of: %s, %s, %s)",
+			source, SourceFile, SourceHTTP, SourceArchive)
+	}
+}
+
+// IsSupportedArchive reports whether the path names a zip, tar or gzip-compressed tar archive.
+func IsSupportedArchive(path string) bool {
+	lowerPath := strings.ToLower(path)
+	for _, ext := range []string{".zip", ".tar", ".tar.gz", ".tgz"} {
+		if strings.HasSuffix(lowerPath, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSourcePath checks if the provided path names an existing archive of a supported format.
+func validateSourcePath(sourcePath string) error {
+	if sourcePath == "" {
+		return errors.New("source path cannot be empty for the archive source")
+	}
+
+	if !IsSupportedArchive(sourcePath) {
+		return fmt.Errorf("invalid source path: %s (must be a .zip, .tar, .tar.gz or .tgz archive)", sourcePath)
+	}
+
+	fileInfo, err := os.Stat(filepath.Clean(sourcePath))
+	if err != nil {
+		return fmt.Errorf("failed to access archive: %s (error: %w)", sourcePath, err)
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return fmt.Errorf("source path is not a file: %s", sourcePath)
+	}
+
+	return nil
+}
+
+// validateSingleFile checks if the combined standards file exists and is a regular file.
+func validateSingleFile(singleFile string) error {
+	fileInfo, err := os.Stat(filepath.Clean(singleFile))
+	if err != nil {
+		return fmt.Errorf("failed to access standards file: %s (error: %w)", singleFile, err)
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return fmt.Errorf("single file path is not a file: %s", singleFile)
+	}
+
+	return nil
+}
+
+// validateSourceURL checks if the provided URL is an absolute http or https URL.
+func validateSourceURL(sourceURL string) error {
+	if sourceURL == "" {
+		return errors.New("source URL cannot be empty for the http source")
+	}
+
+	parsed, err := url.Parse(sourceURL)
+	if err != nil {
+		return fmt.Errorf("invalid source URL: %s (error: %w)", sourceURL, err)
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid source URL: %s (must be an absolute http or https URL)", sourceURL)
+	}
+
+	return nil
+}
+
+// validateAuditFormat checks if the provided audit format is valid.
+func validateAuditFormat(format string) error {
+	switch AuditFormat(strings.ToLower(format)) {
+	case AuditFormatText, AuditFormatJSON, AuditFormatCSV:
+		return nil
+	default:
+		return fmt.Errorf("invalid audit format: %s (must be one of: %s, %s, %s)",
+			format, AuditFormatText, AuditFormatJSON, AuditFormatCSV)
+	}
+}
+
+// validateDisplayNames checks if the provided display name transform is valid.
+func validateDisplayNames(displayNames string) error {
+	switch DisplayNames(strings.ToLower(displayNames)) {
+	case DisplayNamesCanonical, DisplayNamesTitlecase, DisplayNamesTitlecaseOnly:
+		return nil
+	default:
+		return fmt.Errorf("invalid display names: %s (must be one of: %s, %s, %s)",
+			displayNames, DisplayNamesCanonical, DisplayNamesTitlecase, DisplayNamesTitlecaseOnly)
+	}
+}
+
+// validateEmptyGet checks if the provided empty get response is valid.
+func validateEmptyGet(emptyGet string) error {
+	switch EmptyGet(strings.ToLower(emptyGet)) {
+	case EmptyGetNotFound, EmptyGetMessage, EmptyGetEmpty:
+		return nil
+	default:
+		return fmt.Errorf("invalid empty get response: %s (must be one of: %s, %s, %s)",
+			emptyGet, EmptyGetNotFound, EmptyGetMessage, EmptyGetEmpty)
+	}
+}
+
+// validateBudgetUnit checks if the provided budget unit is valid.
+func validateBudgetUnit(unit string) error {
+	switch BudgetUnit(strings.ToLower(unit)) {
+	case BudgetUnitBytes, BudgetUnitTokens:
+		return nil
+	default:
+		return fmt.Errorf("invalid budget unit: %s (must be one of: %s, %s)", unit, BudgetUnitBytes, BudgetUnitTokens)
+	}
+}
+
+// validateAllowlist checks if the provided allowlist patterns are valid glob patterns.
+func validateAllowlist(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid allowlist pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
 // validatePositiveInt checks if the provided integer is positive.
 func validatePositiveInt(value int, name string) error {
 	if value <= 0 {
@@ -48,6 +276,14 @@ func validatePositiveInt(value int, name string) error {
 	return nil
 }
 
+// validateNonNegativeInt checks if the provided integer is zero or positive.
+func validateNonNegativeInt(value int, name string) error {
+	if value < 0 {
+		return fmt.Errorf("%s must not be negative, got: %d", name, value)
+	}
+	return nil
+}
+
 // expandPath expands ~ to user home directory and resolves the path.
 func expandPath(path string) (string, error) {
 	if strings.HasPrefix(path, "~/") {
@@ -60,6 +296,22 @@ func expandPath(path string) (string, error) {
 	return path, nil
 }
 
+// ResolveFolder resolves the standards folder path to a clean absolute path, expanding a leading ~ to the user
+// home directory. The configuration and the standard loaders resolve the folder alike, so that they agree on it.
+func ResolveFolder(path string) (string, error) {
+	expandedPath, err := expandPath(path)
+	if err != nil {
+		return "", err
+	}
+
+	absolutePath, err := filepath.Abs(expandedPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve absolute path of %s: %w", expandedPath, err)
+	}
+
+	return absolutePath, nil
+}
+
 // validateDirectory checks if the directory exists and has appropriate permissions.
 func validateDirectory(path string) error {
 	// Clean the path to prevent directory traversal
@@ -1,11 +1,48 @@
 // Package domain contains core business entities without any external dependencies.
 package domain
 
+import "time"
+
 // StandardInfo represents basic information about a standard.
 // This is a pure domain entity without any serialization tags.
 type StandardInfo struct {
 	Name        string
 	Description string
+	// Path is the slash-separated location of the standard relative to the standards folder.
+	Path string
+	// Language is the language tag of a localized variant, empty for an untagged standard.
+	Language string
+	// Languages lists the language tags of all localized variants grouped under this standard.
+	Languages []string
+	// ReviewBy is the date the standard must be reviewed by, zero if not set.
+	ReviewBy time.Time
+	// Disabled reports whether the standard is staged and not served by default.
+	Disabled bool
+	// Tags are the keywords the standard can be filtered by.
+	Tags []string
+	// Descriptions are the localized descriptions by language tag, nil if the description is not localized.
+	Descriptions map[string]string
+	// When is the condition on the request context under which the standard applies, empty if it always applies.
+	When string
+	// Order is the position of the standard in listings sorted by order, nil if not set.
+	Order *int
+}
+
+// CacheStats represents the effectiveness of the cache of a standards source.
+type CacheStats struct {
+	// Hits is the number of documents served from the cache.
+	Hits int64
+	// Misses is the number of documents fetched because they were not cached or the cached copy had expired.
+	Misses int64
+	// Entries is the number of documents currently cached.
+	Entries int
+}
+
+// SkippedStandard is a requested standard that exists but was not returned.
+type SkippedStandard struct {
+	Name string
+	// Reason tells why the standard was not returned, e.g. that it exceeds the maximum standard size.
+	Reason string
 }
 
 // Standard represents the full content of a standard.
@@ -14,4 +51,20 @@ type Standard struct {
 	Name        string
 	Description string
 	Content     string
+	// Language is the language tag of a localized variant, empty for an untagged standard.
+	Language string
+	// Priority is the importance of the standard from 0 to 1, zero if not set.
+	Priority float64
+	// Disabled reports whether the standard is staged and not served by default.
+	Disabled bool
+	// Title is the human-readable title from the frontmatter, empty if not set.
+	Title string
+	// Tags are the keywords the standard can be filtered by.
+	Tags []string
+	// ReviewBy is the date the standard must be reviewed by, zero if not set.
+	ReviewBy time.Time
+	// Descriptions are the localized descriptions by language tag, nil if the description is not localized.
+	Descriptions map[string]string
+	// When is the condition on the request context under which the standard applies, empty if it always applies.
+	When string
 }
@@ -0,0 +1,28 @@
+package domain
+
+import "errors"
+
+// Sentinel errors returned by the standard loaders, wrapped with details. Use errors.Is to match them.
+var (
+	// ErrPathTraversal is returned when a standard path points outside the standards source.
+	ErrPathTraversal = errors.New("path traversal detected")
+	// ErrFileTooLarge is returned when a standard exceeds the maximum standard size.
+	ErrFileTooLarge = errors.New("file size exceeds maximum limit")
+	// ErrTooManyStandards is returned when the source holds more standards than the maximum number of standards.
+	ErrTooManyStandards = errors.New("number of standards exceeds maximum limit")
+	// ErrStandardNotFound is returned by GetStandard when no standard has the requested name.
+	ErrStandardNotFound = errors.New("standard not found")
+	// ErrStandardSkipped is returned outside strict mode for a requested standard that exists
+	// but exceeds the maximum standard size, so that it is not reported as not found.
+	ErrStandardSkipped = errors.New("exceeds AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE")
+	// ErrFolderDisappeared is returned when the standards folder was read before but no longer exists,
+	// e.g. because its volume was unmounted.
+	ErrFolderDisappeared = errors.New("standards folder disappeared")
+	// ErrChecksumMismatch is returned when the content of a standard does not match its sha256 frontmatter field,
+	// e.g. because the file was corrupted or tampered with.
+	ErrChecksumMismatch = errors.New("standard content checksum mismatch")
+	// ErrDecryptKeyMissing is returned when an encrypted standard is read without AGENT_STANDARDS_MCP_DECRYPT_KEY.
+	ErrDecryptKeyMissing = errors.New("encrypted standard found but AGENT_STANDARDS_MCP_DECRYPT_KEY is not set")
+	// ErrDecryptionFailed is returned when an encrypted standard cannot be decrypted with the configured key.
+	ErrDecryptionFailed = errors.New("failed to decrypt standard")
+)
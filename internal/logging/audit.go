@@ -4,21 +4,39 @@ package logging
 import (
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
 
 	"github.com/n-r-w/agent-standards-mcp/internal/config"
 	"github.com/n-r-w/agent-standards-mcp/internal/shared"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 // Audit provides audit logging functionality for client requests.
 type Audit struct {
 	logger *slog.Logger
+	// structuredLogger writes text events, file holds the events of the other formats.
+	structuredLogger *StructuredLogger
+	file             *auditFile
 }
 
 var _ shared.AuditLogger = (*Audit)(nil)
 
 // NewAudit creates a new Audit logger with the given configuration.
+// Text events are written with the rest of the log, JSON and CSV events to a dedicated audit file
+// in the log directory, so that the file holds nothing but audit records. When the log directory is not
+// writable, JSON and CSV events are written to stderr with a warning, unless file logging is required.
 func NewAudit(cfg *config.Config) (*Audit, error) {
+	return newAudit(cfg, openAuditFile)
+}
+
+// newAudit creates a new Audit logger writing JSON and CSV events to the audit file opened by openFile.
+func newAudit(
+	cfg *config.Config, openFile func(cfg *config.Config, format config.AuditFormat) (*auditFile, error),
+) (*Audit, error) {
 	if cfg == nil {
 		return nil, errors.New("configuration cannot be nil")
 	}
@@ -29,11 +47,160 @@ func NewAudit(cfg *config.Config) (*Audit, error) {
 		return nil, fmt.Errorf("failed to create structured logger for audit: %w", err)
 	}
 
+	format := cfg.GetAuditFormat()
+	if auditFileName(format) == "" || !cfg.IsLoggingEnabled() {
+		return &Audit{
+			logger:           newAuditLogger(format, structuredLogger, io.Discard, false),
+			structuredLogger: structuredLogger,
+			file:             nil,
+		}, nil
+	}
+
+	file, err := openFile(cfg, format)
+	if err != nil && (cfg.IsFileLogRequired() || !isUnwritableError(err)) {
+		_ = structuredLogger.Close()
+		return nil, err
+	}
+	if err != nil {
+		structuredLogger.Warn("Audit file disabled, the log directory is not writable", "error", err)
+		return &Audit{
+			logger:           newAuditLogger(format, structuredLogger, os.Stderr, true),
+			structuredLogger: structuredLogger,
+			file:             nil,
+		}, nil
+	}
+
+	// The audit file writes the CSV header at the start of each file itself
 	return &Audit{
-		logger: structuredLogger.logger,
+		logger:           newAuditLogger(format, structuredLogger, file, false),
+		structuredLogger: structuredLogger,
+		file:             file,
+	}, nil
+}
+
+// auditFileName returns the name of the audit file of the format, or an empty name for text events.
+func auditFileName(format config.AuditFormat) string {
+	switch format {
+	case config.AuditFormatJSON:
+		return "audit.jsonl"
+	case config.AuditFormatCSV:
+		return "audit.csv"
+	case config.AuditFormatText:
+		return ""
+	default:
+		return ""
+	}
+}
+
+// auditFile is the audit file of the JSON and CSV events, rotated with the same limits as the log file.
+// The file is rotated before a write would exceed the size limit, so that each file starts with the header
+// of the format and holds whole records only.
+type auditFile struct {
+	mu         sync.Mutex
+	lumberjack *lumberjack.Logger
+	// header starts each file, empty for formats without a header.
+	header []byte
+	size   int64
+}
+
+// openAuditFile opens the audit file of the format in the log directory for appending.
+func openAuditFile(cfg *config.Config, format config.AuditFormat) (*auditFile, error) {
+	logDir, err := createLogDir(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// lumberjack opens the file on the first write, check it is writable to fail at startup instead
+	fileName := filepath.Join(logDir, auditFileName(format))
+	file, err := os.OpenFile(fileName, os.O_CREATE|os.O_WRONLY|os.O_APPEND, filePermissions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file: %w", err)
+	}
+	info, err := file.Stat()
+	_ = file.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat audit file: %w", err)
+	}
+
+	var header []byte
+	if format == config.AuditFormatCSV {
+		header = csvAuditHeader()
+	}
+
+	return &auditFile{
+		mu:         sync.Mutex{},
+		lumberjack: newLumberjack(fileName),
+		header:     header,
+		size:       info.Size(),
 	}, nil
 }
 
+// Write writes the records to the audit file, rotating it first when the records do not fit.
+func (f *auditFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.size > 0 && f.size+int64(len(p)) > maxLogFileSize*megabyte {
+		if err := f.lumberjack.Rotate(); err != nil {
+			return 0, fmt.Errorf("failed to rotate audit file: %w", err)
+		}
+		f.size = 0
+	}
+
+	if f.size == 0 && len(f.header) > 0 {
+		n, err := f.lumberjack.Write(f.header)
+		f.size += int64(n)
+		if err != nil {
+			return 0, fmt.Errorf("failed to write audit header: %w", err)
+		}
+	}
+
+	n, err := f.lumberjack.Write(p)
+	f.size += int64(n)
+
+	return n, err
+}
+
+// Close closes the audit file.
+func (f *auditFile) Close() error {
+	return f.lumberjack.Close()
+}
+
+// newAuditLogger returns the logger serializing audit events in the given format.
+// Text events share the structured logger, other formats write to the audit output with its level.
+// writeHeader is false when the CSV header is already in the output.
+func newAuditLogger(
+	format config.AuditFormat, structuredLogger *StructuredLogger, output io.Writer, writeHeader bool,
+) *slog.Logger {
+	switch format {
+	case config.AuditFormatJSON:
+		return slog.New(slog.NewJSONHandler(output, &slog.HandlerOptions{
+			AddSource:   false,
+			Level:       structuredLogger.level,
+			ReplaceAttr: nil,
+		}))
+	case config.AuditFormatCSV:
+		return slog.New(newCSVAuditHandler(output, structuredLogger.level, writeHeader))
+	case config.AuditFormatText:
+		return structuredLogger.logger
+	default:
+		return structuredLogger.logger
+	}
+}
+
+// Close closes the audit file and the structured logger of the audit events.
+func (a *Audit) Close() error {
+	var errs []error
+	if a.file != nil {
+		errs = append(errs, a.file.Close())
+	}
+	if a.structuredLogger != nil {
+		errs = append(errs, a.structuredLogger.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
 // LogClientRequest logs a client request with structured data.
 func (a *Audit) LogClientRequest(clientID string, method string, params any) {
 	a.logger.Info("client_request",
@@ -0,0 +1,123 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// csvAuditColumns returns the fixed column order of CSV audit records.
+func csvAuditColumns() []string {
+	return []string{"timestamp", "event", "client_id", "method", "data", "error"}
+}
+
+// csvAuditHeader returns the header row of CSV audit records.
+func csvAuditHeader() []byte {
+	var header bytes.Buffer
+	writer := csv.NewWriter(&header)
+	_ = writer.Write(csvAuditColumns()) // Writing to a buffer does not fail
+	writer.Flush()
+
+	return header.Bytes()
+}
+
+// csvAuditHandler is a slog handler writing audit records as CSV rows.
+// The header is written before the first row. WithAttrs and WithGroup return the same handler,
+// so all audit loggers share one writer.
+type csvAuditHandler struct {
+	mu            sync.Mutex
+	writer        *csv.Writer
+	columns       []string
+	level         slog.Level
+	headerWritten bool
+}
+
+var _ slog.Handler = (*csvAuditHandler)(nil)
+
+// newCSVAuditHandler creates a CSV audit handler writing records of at least the given level to the output.
+// Without writeHeader the rows are appended to an output already starting with the header.
+func newCSVAuditHandler(output io.Writer, level slog.Level, writeHeader bool) *csvAuditHandler {
+	return &csvAuditHandler{
+		mu:            sync.Mutex{},
+		writer:        csv.NewWriter(output),
+		columns:       csvAuditColumns(),
+		level:         level,
+		headerWritten: !writeHeader,
+	}
+}
+
+// Enabled reports whether records of the given level are written.
+func (h *csvAuditHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level
+}
+
+// Handle writes the record as a CSV row.
+func (h *csvAuditHandler) Handle(_ context.Context, record slog.Record) error {
+	row := map[string]string{
+		"timestamp": record.Time.UTC().Format(time.RFC3339Nano),
+		"event":     record.Message,
+	}
+
+	record.Attrs(func(attr slog.Attr) bool {
+		switch attr.Key {
+		case "client_id", "method", "error":
+			row[attr.Key] = attr.Value.String()
+		case "params", "result":
+			row["data"] = csvAuditData(attr.Value.Any())
+		}
+		return true
+	})
+
+	values := make([]string, 0, len(h.columns))
+	for _, column := range h.columns {
+		values = append(values, row[column])
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.headerWritten {
+		if err := h.writer.Write(h.columns); err != nil {
+			return fmt.Errorf("failed to write audit header: %w", err)
+		}
+		h.headerWritten = true
+	}
+
+	if err := h.writer.Write(values); err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+	h.writer.Flush()
+
+	return h.writer.Error()
+}
+
+// WithAttrs returns the handler unchanged, audit records carry all their attributes.
+func (h *csvAuditHandler) WithAttrs(_ []slog.Attr) slog.Handler {
+	return h
+}
+
+// WithGroup returns the handler unchanged, audit records are not grouped.
+func (h *csvAuditHandler) WithGroup(_ string) slog.Handler {
+	return h
+}
+
+// csvAuditData formats request parameters or a response result for the data column.
+// Strings are written as is, other values as JSON.
+func csvAuditData(value any) string {
+	if text, ok := value.(string); ok {
+		return text
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(data)
+}
@@ -21,12 +21,23 @@ const (
 type StructuredLogger struct {
 	logger     *slog.Logger
 	logRotator *LogRotator
+	// level is the minimum level of the log records, also applied to the audit events.
+	level slog.Level
 }
 
 var _ shared.Logger = (*StructuredLogger)(nil)
 
 // NewStructuredLogger creates a new StructuredLogger with the given configuration.
+// When the log directory is not writable, e.g. on a read-only mount, it logs to stderr only with a warning,
+// unless file logging is required by the configuration.
 func NewStructuredLogger(cfg *config.Config) (*StructuredLogger, error) {
+	return newStructuredLogger(cfg, NewLogRotator)
+}
+
+// newStructuredLogger creates a new StructuredLogger writing log files with the rotator created by newRotator.
+func newStructuredLogger(
+	cfg *config.Config, newRotator func(cfg *config.Config) (*LogRotator, error),
+) (*StructuredLogger, error) {
 	// Validate configuration
 	if cfg == nil {
 		return nil, errors.New("configuration cannot be nil")
@@ -38,6 +49,7 @@ func NewStructuredLogger(cfg *config.Config) (*StructuredLogger, error) {
 		return &StructuredLogger{
 			logger:     slog.New(slog.DiscardHandler),
 			logRotator: nil,
+			level:      slog.Level(disabledLogLevel),
 		}, nil
 	}
 
@@ -80,10 +92,18 @@ func NewStructuredLogger(cfg *config.Config) (*StructuredLogger, error) {
 	// If logging is enabled, also set up file logging with rotation
 	if cfg.IsLoggingEnabled() {
 		// Create log rotator for file output
-		rotator, err := NewLogRotator(cfg)
-		if err != nil {
+		rotator, err := newRotator(cfg)
+		if err != nil && (cfg.IsFileLogRequired() || !isUnwritableError(err)) {
 			return nil, fmt.Errorf("failed to create log rotator: %w", err)
 		}
+		if err != nil {
+			logger.Warn("File logging disabled, the log directory is not writable", "error", err)
+			return &StructuredLogger{
+				logger:     logger,
+				logRotator: nil,
+				level:      slogLevel,
+			}, nil
+		}
 		logRotator = rotator
 
 		// Create multi-writer for both stderr and file
@@ -109,6 +129,7 @@ func NewStructuredLogger(cfg *config.Config) (*StructuredLogger, error) {
 	return &StructuredLogger{
 		logger:     logger,
 		logRotator: logRotator,
+		level:      slogLevel,
 	}, nil
 }
 
@@ -2,9 +2,15 @@
 package logging
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
+	"syscall"
 	"testing"
 
 	"github.com/n-r-w/agent-standards-mcp/internal/config"
@@ -159,6 +165,65 @@ func TestNewLogRotator(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNewStructuredLogger_UnwritableLogDirectory(t *testing.T) {
+	readOnly := func(cfg *config.Config) (*LogRotator, error) {
+		return nil, &os.PathError{Op: "mkdir", Path: filepath.Join(cfg.GetFolder(), "logs"), Err: syscall.EROFS}
+	}
+
+	tests := []struct {
+		name       string
+		required   bool
+		newRotator func(cfg *config.Config) (*LogRotator, error)
+		expectErr  bool
+	}{
+		{name: "read-only falls back to stderr", required: false, newRotator: readOnly, expectErr: false},
+		{name: "read-only with required file logs", required: true, newRotator: readOnly, expectErr: true},
+		{
+			name:     "other errors fail",
+			required: false,
+			newRotator: func(*config.Config) (*LogRotator, error) {
+				return nil, errors.New("disk quota exceeded")
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				LogLevel:        "INFO",
+				Folder:          t.TempDir(),
+				RequireFileLogs: tt.required,
+			}
+
+			logger, err := newStructuredLogger(cfg, tt.newRotator)
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "failed to create log rotator")
+				return
+			}
+			require.NoError(t, err)
+			assert.Nil(t, logger.logRotator)
+			require.NoError(t, logger.Close())
+		})
+	}
+}
+
+func TestNewLogRotator_UnwritableLogDirectory(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("permissions are not enforced for root")
+	}
+
+	tempDir := t.TempDir()
+	require.NoError(t, os.Chmod(tempDir, 0o500))
+	t.Cleanup(func() { _ = os.Chmod(tempDir, 0o700) })
+
+	rotator, err := NewLogRotator(&config.Config{LogLevel: "INFO", Folder: tempDir})
+	require.Error(t, err)
+	require.Nil(t, rotator)
+	assert.True(t, isUnwritableError(err))
+}
+
 func TestNewLogRotator_InvalidConfig(t *testing.T) {
 	// This test will fail until NewLogRotator is implemented
 	rotator, err := NewLogRotator(nil)
@@ -264,3 +329,144 @@ func TestLoggerFactory_CreateAudit_InvalidConfig(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, audit)
 }
+
+func TestAudit_Formats(t *testing.T) {
+	// logAuditEvents writes a request and a failed response in the given format
+	logAuditEvents := func(format config.AuditFormat) string {
+		var output bytes.Buffer
+		structuredLogger := &StructuredLogger{
+			logger: slog.New(slog.NewTextHandler(&output, &slog.HandlerOptions{Level: slog.LevelInfo})),
+			level:  slog.LevelInfo,
+		}
+
+		audit := &Audit{logger: newAuditLogger(format, structuredLogger, &output, true)}
+		audit.LogClientRequest("test-client", "get_standards", map[string]any{"standard_names": []string{"go"}})
+		audit.LogClientResponse("test-client", nil, errors.New("standard, not found"))
+
+		return output.String()
+	}
+
+	t.Run("text", func(t *testing.T) {
+		output := logAuditEvents(config.AuditFormatText)
+
+		assert.Contains(t, output, "msg=client_request client_id=test-client method=get_standards")
+		assert.Contains(t, output, "msg=client_response client_id=test-client")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		lines := strings.Split(strings.TrimSpace(logAuditEvents(config.AuditFormatJSON)), "\n")
+		require.Len(t, lines, 2)
+
+		var request map[string]any
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &request))
+		assert.Equal(t, "client_request", request["msg"])
+		assert.Equal(t, "test-client", request["client_id"])
+		assert.Equal(t, "get_standards", request["method"])
+		assert.Equal(t, map[string]any{"standard_names": []any{"go"}}, request["params"])
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(lines[1]), &response))
+		assert.Equal(t, "client_response", response["msg"])
+		assert.Equal(t, "standard, not found", response["error"])
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		records, err := csv.NewReader(strings.NewReader(logAuditEvents(config.AuditFormatCSV))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+
+		assert.Equal(t, []string{"timestamp", "event", "client_id", "method", "data", "error"}, records[0])
+		assert.Equal(t, []string{"client_request", "test-client", "get_standards", `{"standard_names":["go"]}`, ""},
+			records[1][1:])
+		assert.Equal(t, []string{"client_response", "test-client", "", "", "standard, not found"}, records[2][1:])
+		assert.NotEmpty(t, records[1][0])
+	})
+}
+
+func TestNewAudit_AuditFile(t *testing.T) {
+	folder := t.TempDir()
+	cfg := &config.Config{
+		LogLevel:        "INFO",
+		Folder:          folder,
+		MaxStandards:    100,
+		MaxStandardSize: 10240,
+		AuditFormat:     string(config.AuditFormatCSV),
+	}
+
+	// Events of two runs end up in the same file with a single header
+	for range 2 {
+		audit, err := NewAudit(cfg)
+		require.NoError(t, err)
+		audit.LogClientRequest("test-client", "get_standards", nil)
+		require.NoError(t, audit.Close())
+	}
+
+	file, err := os.Open(filepath.Join(folder, "logs", "audit.csv"))
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+
+	records, err := csv.NewReader(file).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, "timestamp", records[0][0])
+	assert.Equal(t, "client_request", records[1][1])
+	assert.Equal(t, "client_request", records[2][1])
+
+	// The audit records stay out of the log file
+	logContent, err := os.ReadFile(filepath.Join(folder, "logs", "agent-standards-mcp.log"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(logContent), "client_request")
+}
+
+func TestNewAudit_UnwritableLogDirectory(t *testing.T) {
+	readOnly := func(cfg *config.Config, _ config.AuditFormat) (*auditFile, error) {
+		return nil, &os.PathError{Op: "mkdir", Path: filepath.Join(cfg.GetFolder(), "logs"), Err: syscall.EROFS}
+	}
+
+	for _, format := range []config.AuditFormat{config.AuditFormatJSON, config.AuditFormatCSV} {
+		t.Run(string(format), func(t *testing.T) {
+			cfg := &config.Config{
+				LogLevel:    "INFO",
+				Folder:      t.TempDir(),
+				AuditFormat: string(format),
+			}
+
+			// The audit events fall back to stderr
+			audit, err := newAudit(cfg, readOnly)
+			require.NoError(t, err)
+			assert.Nil(t, audit.file)
+			require.NoError(t, audit.Close())
+
+			// Required file logs fail the startup
+			cfg.RequireFileLogs = true
+			audit, err = newAudit(cfg, readOnly)
+			require.Error(t, err)
+			assert.Nil(t, audit)
+		})
+	}
+}
+
+func TestAuditFile_Rotation(t *testing.T) {
+	folder := t.TempDir()
+	cfg := &config.Config{LogLevel: "INFO", Folder: folder, AuditFormat: string(config.AuditFormatCSV)}
+
+	file, err := openAuditFile(cfg, config.AuditFormatCSV)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+	file.lumberjack.Compress = false // Keep the rotated file in place for the assertions
+
+	// A record that does not fit rotates the file, and the new file starts with the header again
+	_, err = file.Write([]byte("first\n"))
+	require.NoError(t, err)
+	file.size = maxLogFileSize * megabyte
+	_, err = file.Write([]byte("second\n"))
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(folder, "logs", "audit.csv"))
+	require.NoError(t, err)
+	assert.Equal(t, string(csvAuditHeader())+"second\n", string(content))
+
+	entries, err := os.ReadDir(filepath.Join(folder, "logs"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "expected the current and the rotated audit file")
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"syscall"
 
 	"github.com/n-r-w/agent-standards-mcp/internal/config"
 	"gopkg.in/natefinch/lumberjack.v2"
@@ -15,12 +17,16 @@ import (
 const (
 	// maxLogFileSize is the maximum size of a log file before rotation (100MB).
 	maxLogFileSize = 100
+	// megabyte is the unit of maxLogFileSize.
+	megabyte = 1024 * 1024
 	// maxLogFiles is the maximum number of old log files to retain.
 	maxLogFiles = 7
 	// maxLogAge is the maximum number of days to retain old log files.
 	maxLogAge = 7
 	// dirPermissions is the default permissions for directory creation.
 	dirPermissions = 0750
+	// filePermissions is the permissions of a created log file, the same as lumberjack uses.
+	filePermissions = 0600
 )
 
 // LogRotator provides log rotation functionality using lumberjack.
@@ -35,14 +41,27 @@ func NewLogRotator(cfg *config.Config) (*LogRotator, error) {
 	}
 
 	// Create logs directory
-	logDir := filepath.Join(cfg.GetFolder(), "logs")
-	if err := os.MkdirAll(logDir, dirPermissions); err != nil {
-		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	logDir, err := createLogDir(cfg)
+	if err != nil {
+		return nil, err
 	}
 
-	// Create lumberjack logger for log rotation
+	// lumberjack opens the file on the first write, check it is writable to fail at startup instead
 	logFile := filepath.Join(logDir, "agent-standards-mcp.log")
-	lumberjackLogger := &lumberjack.Logger{
+	file, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, filePermissions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+	_ = file.Close()
+
+	return &LogRotator{
+		lumberjack: newLumberjack(logFile),
+	}, nil
+}
+
+// newLumberjack creates the lumberjack logger rotating the log file.
+func newLumberjack(logFile string) *lumberjack.Logger {
+	return &lumberjack.Logger{
 		Filename:   logFile,
 		MaxSize:    maxLogFileSize, // megabytes
 		MaxBackups: maxLogFiles,    // files
@@ -50,10 +69,22 @@ func NewLogRotator(cfg *config.Config) (*LogRotator, error) {
 		Compress:   true,           // compress old log files
 		LocalTime:  true,           // use local time
 	}
+}
 
-	return &LogRotator{
-		lumberjack: lumberjackLogger,
-	}, nil
+// createLogDir creates the directory of the log files in the standards folder and returns its path.
+func createLogDir(cfg *config.Config) (string, error) {
+	logDir := filepath.Join(cfg.GetFolder(), "logs")
+	if err := os.MkdirAll(logDir, dirPermissions); err != nil {
+		return "", fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	return logDir, nil
+}
+
+// isUnwritableError reports whether the error is caused by a read-only file system or missing permissions,
+// e.g. when the standards folder is mounted read-only.
+func isUnwritableError(err error) bool {
+	return errors.Is(err, syscall.EROFS) || errors.Is(err, fs.ErrPermission)
 }
 
 // Writer returns the underlying writer for the log rotator.
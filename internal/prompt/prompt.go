@@ -18,6 +18,9 @@ var loadRelevantStandardsPrompt []byte
 //go:embed follow-standards-prompt.txt
 var followStandardsPrompt []byte
 
+//go:embed no-results-prompt.txt
+var noResultsPrompt []byte
+
 // SystemPrompt returns the system prompt as a string.
 func SystemPrompt() string {
 	return string(systemPrompt)
@@ -42,3 +45,8 @@ func LoadRelevantStandardsPrompt() string {
 func FollowStandardsPrompt() string {
 	return string(followStandardsPrompt)
 }
+
+// NoResultsPrompt returns the guidance shown when a filtered request matches no standards.
+func NoResultsPrompt() string {
+	return string(noResultsPrompt)
+}
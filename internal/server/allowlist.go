@@ -0,0 +1,33 @@
+package server
+
+import (
+	"path"
+	"slices"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// allowed reports whether the standard name matches the configured allowlist of names and glob patterns.
+// All standards are allowed when the allowlist is empty. Patterns use path.Match syntax, so that "*" does not
+// cross the "/" of nested standard names, e.g. "go/*" matches "go/errors" but not "go/http/errors".
+func (s *MCP) allowed(name string) bool {
+	patterns := s.cfg.GetAllowlist()
+	if len(patterns) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		matched, err := path.Match(pattern, name)
+		return err == nil && matched
+	})
+}
+
+// filterAllowedInfos removes the standards missing from the allowlist.
+func (s *MCP) filterAllowedInfos(infos []domain.StandardInfo) []domain.StandardInfo {
+	return slices.DeleteFunc(infos, func(info domain.StandardInfo) bool { return !s.allowed(info.Name) })
+}
+
+// filterAllowedNames removes the names missing from the allowlist, so that their standards are not loaded.
+func (s *MCP) filterAllowedNames(names []string) []string {
+	return slices.DeleteFunc(slices.Clone(names), func(name string) bool { return !s.allowed(name) })
+}
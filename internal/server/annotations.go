@@ -0,0 +1,22 @@
+package server
+
+import (
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+)
+
+// readOnlyToolAnnotations returns the annotations of a tool that only reads standards or the server setup.
+// Such tools never modify their environment, so repeated calls have no additional effect.
+// They interact with an open world only when standards are fetched from a remote server.
+func (s *MCP) readOnlyToolAnnotations(title string) *mcp.ToolAnnotations {
+	destructive := false
+	openWorld := s.cfg.GetSource() == config.SourceHTTP
+
+	return &mcp.ToolAnnotations{
+		DestructiveHint: &destructive,
+		IdempotentHint:  true,
+		OpenWorldHint:   &openWorld,
+		ReadOnlyHint:    true,
+		Title:           title,
+	}
+}
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+const (
+	// truncatedContentMarker ends the content of a standard truncated to fit the response size limit.
+	truncatedContentMarker = "\n...[truncated %d bytes]..."
+	// truncatedTokensMarker ends the content of a standard truncated to fit a response size limit in tokens.
+	truncatedTokensMarker = "\n...[truncated about %d tokens]..."
+	// omittedStandardsNote tells the client which standards did not fit the response size limit.
+	omittedStandardsNote = "Omitted %d standard(s) exceeding the response size limit of %d %s: %s. " +
+		"Call get_standards with fewer names to get them."
+	// omittedTokensNote follows the omitted standards note with their estimated size for a limit in tokens.
+	omittedTokensNote = " They are about %d tokens."
+)
+
+// responseBudget limits the total size of standard contents returned by get_standards.
+// The zero value is unlimited and counts bytes.
+type responseBudget struct {
+	// limit is the maximum total content size in the unit, 0 for unlimited.
+	limit int
+	// truncate is true when the first standard exceeding the limit is truncated instead of omitted.
+	truncate bool
+	// unit is the unit sizes are counted in, bytes if empty.
+	unit config.BudgetUnit
+	// charsPerToken is the number of characters estimated as one token when the unit is tokens.
+	charsPerToken int
+}
+
+// responseBudget returns the configured response size limit.
+func (s *MCP) responseBudget() responseBudget {
+	return responseBudget{
+		limit:         s.cfg.GetMaxResponseSize(),
+		truncate:      s.cfg.IsTruncateContentEnabled(),
+		unit:          s.cfg.GetBudgetUnit(),
+		charsPerToken: s.cfg.GetCharsPerToken(),
+	}
+}
+
+// lowered returns the budget capped at the limit requested by the client. A limit of 0 or above
+// the configured one keeps the configured limit, so clients can only lower it.
+func (b responseBudget) lowered(limit int) responseBudget {
+	if limit > 0 && (b.limit <= 0 || limit < b.limit) {
+		b.limit = limit
+	}
+
+	return b
+}
+
+// countsTokens reports whether sizes are estimated in tokens rather than counted in bytes.
+func (b responseBudget) countsTokens() bool {
+	return b.unit == config.BudgetUnitTokens && b.charsPerToken > 0
+}
+
+// size returns the size of the content in the unit of the budget. Tokens are estimated as the number of
+// characters divided by the characters per token, rounded up.
+func (b responseBudget) size(content string) int {
+	if !b.countsTokens() {
+		return len(content)
+	}
+
+	return (utf8.RuneCountInString(content) + b.charsPerToken - 1) / b.charsPerToken
+}
+
+// unitName returns the name of the unit of the budget in notes.
+func (b responseBudget) unitName() string {
+	if b.countsTokens() {
+		return string(config.BudgetUnitTokens)
+	}
+	return string(config.BudgetUnitBytes)
+}
+
+// apply returns the standards fitting into the budget, in order, the names of the omitted ones and their
+// total size in the unit of the budget.
+// Without truncation, standards that do not fit are omitted and the following ones still fill the
+// remaining budget. With truncation, the first standard that does not fit is cut to the remaining
+// budget, marker included, and the standards after it are omitted.
+func (b responseBudget) apply(standards []domain.Standard) ([]domain.Standard, []string, int) {
+	if b.limit <= 0 {
+		return standards, nil, 0
+	}
+
+	remaining := b.limit
+	kept := make([]domain.Standard, 0, len(standards))
+	var omitted []string
+	omittedSize := 0
+
+	for _, standard := range standards {
+		size := b.size(standard.Content)
+		if size <= remaining {
+			kept = append(kept, standard)
+			remaining -= size
+			continue
+		}
+
+		if b.truncate && remaining > 0 {
+			if content, ok := b.truncateContent(standard.Content, remaining); ok {
+				standard.Content = content
+				kept = append(kept, standard)
+			} else {
+				omitted = append(omitted, standard.Name)
+				omittedSize += size
+			}
+			// Nothing else fits after a truncated standard
+			remaining = 0
+			continue
+		}
+
+		omitted = append(omitted, standard.Name)
+		omittedSize += size
+	}
+
+	return kept, omitted, omittedSize
+}
+
+// truncateContent cuts the content so that, with the truncation marker appended, it fits into the limit
+// in the unit of the budget. It returns false if not even the marker fits.
+func (b responseBudget) truncateContent(content string, limit int) (string, bool) {
+	if !b.countsTokens() {
+		return truncateContent(content, limit)
+	}
+
+	// The marker for the whole content is the longest possible, so the actual one fits as well.
+	// Markers are ASCII, so their length in bytes is their length in characters.
+	keep := limit*b.charsPerToken - len(fmt.Sprintf(truncatedTokensMarker, b.size(content)))
+	if keep < 0 {
+		return "", false
+	}
+
+	cut := len(content)
+	for i := range content {
+		if keep == 0 {
+			cut = i
+			break
+		}
+		keep--
+	}
+
+	return content[:cut] + fmt.Sprintf(truncatedTokensMarker, b.size(content[cut:])), true
+}
+
+// truncateContent cuts the content on a rune boundary so that, with the truncation marker appended,
+// it fits into maxBytes. It returns false if not even the marker fits.
+func truncateContent(content string, maxBytes int) (string, bool) {
+	// The marker for the whole content is the longest possible, so the actual one fits as well
+	cut := maxBytes - len(fmt.Sprintf(truncatedContentMarker, len(content)))
+	if cut < 0 {
+		return "", false
+	}
+
+	for cut > 0 && !utf8.RuneStart(content[cut]) {
+		cut--
+	}
+
+	return content[:cut] + fmt.Sprintf(truncatedContentMarker, len(content)-cut), true
+}
+
+// omittedNote formats the note listing standards omitted by the response size limit.
+// For a limit in tokens, the note ends with the estimated size of the omitted standards.
+func (b responseBudget) omittedNote(omitted []string, omittedSize int) string {
+	note := fmt.Sprintf(omittedStandardsNote, len(omitted), b.limit, b.unitName(), strings.Join(omitted, ", "))
+	if b.countsTokens() {
+		note += fmt.Sprintf(omittedTokensNote, omittedSize)
+	}
+
+	return note
+}
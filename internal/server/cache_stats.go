@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"time"
+)
+
+// startCacheStats logs the statistics of the standards cache at the configured interval in the background,
+// until the context is cancelled or the server is stopped. Nothing is logged when the interval is not set
+// or the standards source has no cache.
+func (s *MCP) startCacheStats(ctx context.Context) {
+	if _, ok := s.standardLoader.(CacheStatsProvider); !ok || s.cfg.GetCacheStatsInterval() <= 0 {
+		return
+	}
+
+	go s.runCacheStats(ctx)
+}
+
+// runCacheStats logs the statistics of the standards cache at the configured interval until the context
+// is cancelled or the server is stopped.
+func (s *MCP) runCacheStats(ctx context.Context) {
+	provider, ok := s.standardLoader.(CacheStatsProvider)
+	interval := s.cfg.GetCacheStatsInterval()
+	if !ok || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopped:
+			return
+		case <-ticker.C:
+			stats := provider.CacheStats()
+
+			hitRatio := 0.0
+			if lookups := stats.Hits + stats.Misses; lookups > 0 {
+				hitRatio = float64(stats.Hits) / float64(lookups)
+			}
+
+			s.logger.Info("Cache statistics", "hits", stats.Hits, "misses", stats.Misses,
+				"hit_ratio", hitRatio, "entries", stats.Entries)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+	"github.com/n-r-w/agent-standards-mcp/internal/shared"
+)
+
+// clientLoggerName is the logger name reported in MCP logging notifications.
+const clientLoggerName = "agent-standards-mcp"
+
+// clientLogger writes structured logs to the server logger and forwards them
+// to the connected MCP session as logging notifications.
+type clientLogger struct {
+	logger   shared.Logger
+	session  *slog.Logger
+	minLevel slog.Level
+}
+
+var _ shared.Logger = (*clientLogger)(nil)
+
+// requestLogger returns the logger to use while handling a tool request.
+// When client logging is enabled and the request has a session, logs are also forwarded to the client.
+func (s *MCP) requestLogger(request *mcp.CallToolRequest) shared.Logger {
+	if !s.cfg.IsClientLoggingEnabled() || request == nil || request.Session == nil {
+		return s.logger
+	}
+
+	return &clientLogger{
+		logger: s.logger,
+		session: slog.New(mcp.NewLoggingHandler(request.Session, &mcp.LoggingHandlerOptions{
+			LoggerName:  clientLoggerName,
+			MinInterval: 0,
+		})),
+		minLevel: toSlogLevel(s.cfg.GetClientLogLevel()),
+	}
+}
+
+// loaderContext returns a context carrying the request logger for the standard loader,
+// so that it can trace the files it resolves and warn about skipped files and directories.
+// The logger is only passed when warnings or debug logs are written anywhere.
+func (s *MCP) loaderContext(ctx context.Context, logger shared.Logger) context.Context {
+	warnClient := s.cfg.IsClientLoggingEnabled() && writesWarnings(s.cfg.GetClientLogLevel())
+	if !writesWarnings(s.cfg.GetLogLevel()) && !warnClient {
+		return ctx
+	}
+
+	return shared.WithLogger(ctx, logger)
+}
+
+// writesWarnings reports whether warnings are written at the given minimum log level.
+func writesWarnings(level config.LogLevel) bool {
+	return level == config.LogLevelDebug || level == config.LogLevelInfo || level == config.LogLevelWarn
+}
+
+// Debug logs a debug message with structured data.
+func (c *clientLogger) Debug(msg string, args ...any) {
+	c.logger.Debug(msg, args...)
+	c.forward(slog.LevelDebug, msg, args...)
+}
+
+// Info logs an info message with structured data.
+func (c *clientLogger) Info(msg string, args ...any) {
+	c.logger.Info(msg, args...)
+	c.forward(slog.LevelInfo, msg, args...)
+}
+
+// Warn logs a warning message with structured data.
+func (c *clientLogger) Warn(msg string, args ...any) {
+	c.logger.Warn(msg, args...)
+	c.forward(slog.LevelWarn, msg, args...)
+}
+
+// Error logs an error message with structured data.
+func (c *clientLogger) Error(msg string, args ...any) {
+	c.logger.Error(msg, args...)
+	c.forward(slog.LevelError, msg, args...)
+}
+
+// forward sends the log record to the client if it reaches the configured minimum level.
+func (c *clientLogger) forward(level slog.Level, msg string, args ...any) {
+	if level < c.minLevel {
+		return
+	}
+
+	c.session.Log(context.Background(), level, msg, args...)
+}
+
+// toSlogLevel converts a configuration log level to the corresponding slog level.
+func toSlogLevel(level config.LogLevel) slog.Level {
+	switch level {
+	case config.LogLevelDebug:
+		return slog.LevelDebug
+	case config.LogLevelInfo:
+		return slog.LevelInfo
+	case config.LogLevelWarn:
+		return slog.LevelWarn
+	case config.LogLevelError, config.LogLevelNone:
+		return slog.LevelError
+	default:
+		return slog.LevelError
+	}
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"slices"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/condition"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// contextSchema is the input schema of the context parameter of list_standards and get_standards.
+func contextSchema() map[string]any {
+	return map[string]any{
+		"type":                 "object",
+		"additionalProperties": map[string]any{"type": []string{"string", "number", "boolean"}},
+		"description": "Optional facts about the task, e.g. {\"language\": \"go\"}, to skip standards whose " +
+			"'when' condition does not hold. Without context all standards apply",
+	}
+}
+
+// appliesIn reports whether a standard with the condition applies in the request context.
+// Standards without a condition always apply, and so do all standards when the request has no or an empty context.
+// Conditions are validated when standards are loaded, an invalid one never applies.
+func appliesIn(when string, context map[string]string) bool {
+	if when == "" || len(context) == 0 {
+		return true
+	}
+
+	matches, err := condition.Matches(when, context)
+	return err == nil && matches
+}
+
+// filterInfosByContext removes the standards whose condition does not hold in the request context.
+func filterInfosByContext(infos []domain.StandardInfo, context map[string]string) []domain.StandardInfo {
+	return slices.DeleteFunc(infos, func(info domain.StandardInfo) bool { return !appliesIn(info.When, context) })
+}
+
+// filterStandardsByContext removes the standards whose condition does not hold in the request context.
+func filterStandardsByContext(standards []domain.Standard, context map[string]string) []domain.Standard {
+	return slices.DeleteFunc(standards, func(standard domain.Standard) bool {
+		return !appliesIn(standard.When, context)
+	})
+}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// configView is the non-sensitive part of the resolved configuration returned by the get_config tool.
+type configView struct {
+	Folder           string   `json:"folder"`
+	LogLevel         string   `json:"log_level"`
+	MaxStandards     int      `json:"max_standards"`
+	MaxStandardSize  int      `json:"max_standard_size"`
+	Recursive        bool     `json:"recursive"`
+	MaxDepth         int      `json:"max_depth"`
+	ClientLogs       string   `json:"client_logs"`
+	NameStrategy     string   `json:"name_strategy"`
+	LanguageVariants bool     `json:"language_variants"`
+	DefaultLanguage  string   `json:"default_language"`
+	ListLimit        int      `json:"default_list_limit"`
+	MaxGetNames      int      `json:"max_get_names"`
+	Annotations      bool     `json:"content_annotations"`
+	TemplateVars     []string `json:"template_vars"`
+	Allowlist        []string `json:"allowlist"`
+	Source           string   `json:"source"`
+	SourceURL        string   `json:"source_url"`
+	SourcePath       string   `json:"source_path"`
+	SingleFile       string   `json:"single_file"`
+	Strict           bool     `json:"strict"`
+	MaxResponseSize  int      `json:"max_response_size"`
+	BudgetUnit       string   `json:"budget_unit"`
+	CharsPerToken    int      `json:"chars_per_token"`
+	TruncateContent  bool     `json:"truncate_content"`
+	MaxInputBytes    int      `json:"max_input_bytes"`
+	EmptyGet         string   `json:"empty_get"`
+	CacheTTL         string   `json:"cache_ttl"`
+	RequestDeadline  string   `json:"request_deadline"`
+}
+
+// registerConfigTool registers the get_config tool with the MCP server.
+func (s *MCP) registerConfigTool() {
+	getConfigInputSchema := map[string]any{
+		"type":       "object",
+		"properties": map[string]any{},
+	}
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name: "get_config",
+		Description: "Returns the resolved configuration of the agent-standards-mcp server: " +
+			"standards folder, log level, limits and enabled features. Use it to debug the server setup.",
+		InputSchema:  getConfigInputSchema,
+		OutputSchema: toolOutputSchema("Resolved server configuration as JSON"),
+		Meta:         mcp.Meta{},
+		Annotations:  s.readOnlyToolAnnotations("Get Config"),
+		Title:        "Get Config",
+	}, func(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
+		*mcp.CallToolResult, toolOutput, error,
+	) {
+		result, err := s.handleGetConfig(ctx, request, input)
+		return s.toolResult(result, err, input, getConfigInputSchema)
+	})
+}
+
+// handleGetConfig handles the get_config tool request.
+func (s *MCP) handleGetConfig(_ context.Context, request *mcp.CallToolRequest, input map[string]any) (
+	*mcp.CallToolResult,
+	error,
+) {
+	metadata := newRequestMetadata(request.Session)
+	s.auditLogger.LogClientRequest(metadata.ClientID, "get_config", input)
+
+	logger := s.requestLogger(request)
+	logger.Debug("Getting config", "client", metadata.ClientID, "session_id", metadata.SessionID)
+
+	view := configView{
+		Folder:           s.cfg.GetFolder(),
+		LogLevel:         string(s.cfg.GetLogLevel()),
+		MaxStandards:     s.cfg.GetMaxStandards(),
+		MaxStandardSize:  s.cfg.GetMaxStandardSize(),
+		Recursive:        s.cfg.IsRecursive(),
+		MaxDepth:         s.cfg.GetMaxDepth(),
+		ClientLogs:       string(s.cfg.GetClientLogLevel()),
+		NameStrategy:     string(s.cfg.GetNameStrategy()),
+		LanguageVariants: s.cfg.IsLanguageVariantsEnabled(),
+		DefaultLanguage:  s.cfg.GetDefaultLanguage(),
+		ListLimit:        s.cfg.GetDefaultListLimit(),
+		MaxGetNames:      s.cfg.GetMaxGetNames(),
+		Annotations:      s.cfg.IsContentAnnotationsEnabled(),
+		TemplateVars:     s.cfg.GetTemplateVars(),
+		Allowlist:        s.cfg.GetAllowlist(),
+		Source:           string(s.cfg.GetSource()),
+		SourceURL:        s.cfg.GetSourceURL(),
+		SourcePath:       s.cfg.GetSourcePath(),
+		SingleFile:       s.cfg.GetSingleFile(),
+		Strict:           s.cfg.IsStrict(),
+		MaxResponseSize:  s.cfg.GetMaxResponseSize(),
+		BudgetUnit:       string(s.cfg.GetBudgetUnit()),
+		CharsPerToken:    s.cfg.GetCharsPerToken(),
+		TruncateContent:  s.cfg.IsTruncateContentEnabled(),
+		MaxInputBytes:    s.cfg.GetMaxInputBytes(),
+		EmptyGet:         string(s.cfg.GetEmptyGet()),
+		CacheTTL:         s.cfg.GetCacheTTL().String(),
+		RequestDeadline:  s.cfg.GetRequestDeadline().String(),
+	}
+
+	data, err := json.MarshalIndent(view, "", "  ")
+	if err != nil {
+		err = fmt.Errorf("failed to marshal config: %w", err)
+		logger.Error("Failed to get config", "error", err)
+		return s.fail(metadata, err)
+	}
+
+	formattedResult := string(data)
+
+	s.auditLogger.LogClientResponse(metadata.ClientID, formattedResult, nil)
+	return &mcp.CallToolResult{
+		IsError:           false,
+		Meta:              mcp.Meta{},
+		Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: formattedResult}},
+		StructuredContent: newToolOutput(formattedResult),
+	}, nil
+}
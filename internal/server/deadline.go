@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// limitRequestDuration runs tool calls with the configured request deadline. The deadline reaches the loader
+// through the request context, so that long scans abort, and cancellation by the client still applies.
+// A call running past the deadline fails with a deadline error instead of returning a partial result.
+// The handler has audited its own response by then, so the replacing error is audited as well.
+func (s *MCP) limitRequestDuration(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		request, ok := req.(*mcp.CallToolRequest)
+		deadline := s.cfg.GetRequestDeadline()
+		if !ok || deadline <= 0 || request.Params == nil {
+			return next(ctx, method, req)
+		}
+
+		deadlineCtx, cancel := context.WithTimeout(ctx, deadline)
+		defer cancel()
+
+		result, err := next(deadlineCtx, method, req)
+		if ctx.Err() != nil || !errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
+			return result, err
+		}
+
+		err = fmt.Errorf("tool call exceeded the request deadline of %s: %w", deadline, context.DeadlineExceeded)
+		s.logger.Warn("Tool call exceeded the request deadline", "tool", request.Params.Name, "deadline", deadline)
+		s.auditLogger.LogClientResponse(newRequestMetadata(request.Session).ClientID, nil, err)
+
+		return newErrorResult(err), nil
+	}
+}
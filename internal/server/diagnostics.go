@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+)
+
+// transportStdio is the transport the server is started with by Start.
+const transportStdio = "stdio"
+
+// LogStartupDiagnostics logs a single structured event describing the effective setup of the server:
+// the resolved standards location, the number of standards discovered, the enabled tools, the transport
+// and the effective limits. A failed discovery is reported in the event and is not fatal.
+func (s *MCP) LogStartupDiagnostics(ctx context.Context) {
+	discovered := -1
+	var discoveryErr error
+	if infos, err := s.standardLoader.ListStandards(ctx); err != nil {
+		discoveryErr = err
+	} else {
+		discovered = len(infos)
+	}
+
+	s.logger.Info("Starting agent-standards-mcp server",
+		"version", s.buildInfo.Version,
+		"commit", s.buildInfo.Commit,
+		"built", s.buildInfo.Date,
+		"built_by", s.buildInfo.BuiltBy,
+		"source", s.cfg.GetSource(),
+		"standards_location", s.standardsLocation(),
+		"standards_discovered", discovered,
+		"discovery_error", discoveryErr,
+		"tools", s.enabledTools(),
+		"transport", transportStdio,
+		"log_level", s.cfg.GetLogLevel(),
+		"max_standards", s.cfg.GetMaxStandards(),
+		"max_standard_size", s.cfg.GetMaxStandardSize(),
+		"default_list_limit", s.cfg.GetDefaultListLimit(),
+		"max_get_names", s.cfg.GetMaxGetNames(),
+		"empty_get", s.cfg.GetEmptyGet(),
+		"max_response_size", s.cfg.GetMaxResponseSize(),
+		"budget_unit", s.cfg.GetBudgetUnit(),
+		"chars_per_token", s.cfg.GetCharsPerToken(),
+		"max_input_bytes", s.cfg.GetMaxInputBytes(),
+		"request_deadline", s.cfg.GetRequestDeadline(),
+		"truncate_content", s.cfg.IsTruncateContentEnabled(),
+		"strict", s.cfg.IsStrict(),
+		"recursive", s.cfg.IsRecursive(),
+		"max_depth", s.cfg.GetMaxDepth(),
+		"read_retries", s.cfg.GetReadRetries(),
+		"client_logs", s.cfg.ClientLogs,
+		"echo_input_on_error", s.cfg.IsEchoInputOnErrorEnabled(),
+		"name_strategy", s.cfg.GetNameStrategy(),
+		"display_names", s.cfg.GetDisplayNames(),
+		"language_variants", s.cfg.IsLanguageVariantsEnabled(),
+		"default_language", s.cfg.GetDefaultLanguage(),
+		"content_annotations", s.cfg.IsContentAnnotationsEnabled(),
+		"template_vars", s.cfg.GetTemplateVars(),
+		"allowlist", s.cfg.GetAllowlist(),
+		"require_standards", s.cfg.IsStandardsRequired(),
+		"require_file_logs", s.cfg.IsFileLogRequired(),
+		"prewarm", s.cfg.IsPrewarmEnabled(),
+		"cache_ttl", s.cfg.GetCacheTTL(),
+		"shared_scan_cache", s.cfg.IsSharedScanCacheEnabled(),
+		"cache_stats_interval", s.cfg.GetCacheStatsInterval(),
+		"audit_format", s.cfg.GetAuditFormat(),
+		"normalize_newlines", s.cfg.IsNormalizeNewlinesEnabled(),
+		"preserve_whitespace", s.cfg.IsPreserveWhitespaceEnabled(),
+		"decryption", s.cfg.IsDecryptionEnabled(),
+		"default_description", s.cfg.GetDefaultDescription(),
+		"empty_description_ok", s.cfg.IsEmptyDescriptionAllowed(),
+		"title_from_heading", s.cfg.IsTitleFromHeadingEnabled(),
+		"strip_title_heading", s.cfg.IsStripTitleHeadingEnabled(),
+		"strip_html_comments", s.cfg.IsStripCommentsEnabled(),
+		"demote_headings", s.cfg.IsDemoteHeadingsEnabled(),
+		"content_prefix", s.cfg.GetContentPrefix(),
+		"content_suffix", s.cfg.GetContentSuffix(),
+		"trailing_newline", s.cfg.IsTrailingNewlineEnabled(),
+	)
+}
+
+// standardsLocation returns where the standards are read from: the absolute standards folder,
+// the combined standards file, the source URL or the archive path, depending on the configured source.
+func (s *MCP) standardsLocation() string {
+	switch s.cfg.GetSource() {
+	case config.SourceHTTP:
+		return s.cfg.GetSourceURL()
+	case config.SourceArchive:
+		return s.cfg.GetSourcePath()
+	case config.SourceFile:
+		if singleFile := s.cfg.GetSingleFile(); singleFile != "" {
+			return absolutePath(singleFile)
+		}
+		return absolutePath(s.cfg.GetFolder())
+	default:
+		return absolutePath(s.cfg.GetFolder())
+	}
+}
+
+// enabledTools returns the names of the tools registered by RegisterTools.
+func (s *MCP) enabledTools() []string {
+	tools := []string{"list_standards", "get_standards", "get_standard_meta", "list_tags", "server_info"}
+	if _, ok := s.standardLoader.(PathStandardLoader); ok {
+		tools = append(tools, "get_standards_by_path")
+	}
+	if s.cfg.IsConfigToolEnabled() {
+		tools = append(tools, "get_config")
+	}
+
+	return tools
+}
+
+// absolutePath returns the absolute form of a path, or the path itself if it cannot be resolved.
+func absolutePath(path string) string {
+	if absPath, err := filepath.Abs(path); err == nil {
+		return absPath
+	}
+
+	return path
+}
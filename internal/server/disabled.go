@@ -0,0 +1,28 @@
+package server
+
+import (
+	"slices"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// disabledFlag marks standards disabled by their frontmatter in the list_standards output.
+const disabledFlag = "[DISABLED]"
+
+// filterDisabledInfos removes disabled standards from the list unless they are explicitly included.
+func filterDisabledInfos(infos []domain.StandardInfo, includeDisabled bool) []domain.StandardInfo {
+	if includeDisabled {
+		return infos
+	}
+
+	return slices.DeleteFunc(infos, func(info domain.StandardInfo) bool { return info.Disabled })
+}
+
+// filterDisabledStandards removes disabled standards from the result unless they are explicitly included.
+func filterDisabledStandards(standards []domain.Standard, includeDisabled bool) []domain.Standard {
+	if includeDisabled {
+		return standards
+	}
+
+	return slices.DeleteFunc(standards, func(standard domain.Standard) bool { return standard.Disabled })
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+)
+
+// displayName returns the name of a standard as displayed by list_standards with the given transform.
+func displayName(name string, displayNames config.DisplayNames) string {
+	switch displayNames {
+	case config.DisplayNamesTitlecase:
+		return name + " (" + humanizeName(name) + ")"
+	case config.DisplayNamesTitlecaseOnly:
+		return humanizeName(name)
+	case config.DisplayNamesCanonical:
+		return name
+	default:
+		return name
+	}
+}
+
+// humanizeName turns a standard name into title case words: dashes, underscores and dots separate words,
+// and the directories of nested standards are kept as " / " separated parts, e.g. "go/error_handling"
+// becomes "Go / Error Handling".
+func humanizeName(name string) string {
+	parts := strings.Split(name, "/")
+	for i, part := range parts {
+		words := strings.FieldsFunc(part, func(r rune) bool {
+			return r == '-' || r == '_' || r == '.' || unicode.IsSpace(r)
+		})
+		for j, word := range words {
+			first, size := utf8.DecodeRuneInString(word)
+			words[j] = string(unicode.ToUpper(first)) + word[size:]
+		}
+		parts[i] = strings.Join(words, " ")
+	}
+
+	return strings.Join(parts, " / ")
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+	"github.com/n-r-w/agent-standards-mcp/internal/prompt"
+	"github.com/n-r-w/agent-standards-mcp/internal/shared"
+)
+
+const (
+	// formatDocument is the get_standards format emitting a single markdown document.
+	formatDocument = "document"
+	// documentTOCHeading starts the table of contents of a document.
+	documentTOCHeading = "## Contents"
+	// defaultAnchor is the anchor of standards whose name has no letters or digits.
+	defaultAnchor = "standard"
+)
+
+// formatStandardsDocument formats the standards as a single markdown document: a table of contents
+// of links to the standards, followed by each standard under an anchored heading instead of a code fence.
+// The heading replaces the configured wrapper, the description still follows the wrapper settings.
+// Top-level headings of the content are demoted, so that they do not break the outline of the document.
+func formatStandardsDocument(standards []domain.Standard, wrapper contentWrapper) string {
+	if len(standards) == 0 {
+		return "No standards found."
+	}
+
+	anchors := standardAnchors(standards)
+
+	var builder strings.Builder
+
+	builder.WriteString(prompt.FollowStandardsPrompt() + "\n\n" + documentTOCHeading + "\n")
+	for i, standard := range standards {
+		fmt.Fprintf(&builder, "\n- [%s](#%s)", standard.Name, anchors[i])
+	}
+
+	for i, standard := range standards {
+		fmt.Fprintf(&builder, "\n\n<a id=\"%s\"></a>\n\n## %s", anchors[i], standard.Name)
+		if description := wrapper.description(standard); description != "" {
+			builder.WriteString("\n\n" + description)
+		}
+		builder.WriteString("\n\n" + strings.TrimSpace(shared.DemoteHeadings(standard.Content)))
+	}
+
+	return builder.String()
+}
+
+// standardAnchors returns the anchors of the standards in output order.
+// Names with the same slug get a numeric suffix, so that every anchor is unique.
+func standardAnchors(standards []domain.Standard) []string {
+	anchors := make([]string, 0, len(standards))
+	used := make(map[string]bool, len(standards))
+	for _, standard := range standards {
+		base := shared.Slugify(standard.Name)
+		if base == "" {
+			base = defaultAnchor
+		}
+		anchor := base
+		for n := 1; used[anchor]; n++ {
+			anchor = base + "-" + strconv.Itoa(n)
+		}
+		used[anchor] = true
+		anchors = append(anchors, anchor)
+	}
+
+	return anchors
+}
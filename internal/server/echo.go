@@ -0,0 +1,50 @@
+package server
+
+import (
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// redactedValue replaces input values that are not parameters of the tool.
+const redactedValue = "[REDACTED]"
+
+// toolResult converts the result of a tool handler into the result of a typed tool.
+// Failed calls are reported by the SDK from the error alone, unless the input is echoed on errors or the error
+// is typed: then the error result is returned as is, with the redacted input in its structured content if echoed.
+func (s *MCP) toolResult(
+	result *mcp.CallToolResult, err error, input, inputSchema map[string]any,
+) (*mcp.CallToolResult, toolOutput, error) {
+	if err == nil {
+		return result, outputOf(result), nil
+	}
+
+	// Typed errors are returned as results, as the SDK drops the structured content of failed calls
+	typed := result != nil && outputOf(result).Error != nil
+	if (!s.cfg.IsEchoInputOnErrorEnabled() || result == nil) && !typed {
+		return result, newToolOutput(""), err
+	}
+
+	output := outputOf(result)
+	if s.cfg.IsEchoInputOnErrorEnabled() {
+		output.Input = redactInput(input, inputSchema)
+	}
+	result.StructuredContent = output
+
+	return result, output, nil
+}
+
+// redactInput returns a copy of the input in which the values of keys that are not parameters
+// of the input schema are redacted, so that only the expected parameters are echoed verbatim.
+func redactInput(input, inputSchema map[string]any) map[string]any {
+	properties, _ := inputSchema["properties"].(map[string]any)
+
+	redacted := make(map[string]any, len(input))
+	for key, value := range input {
+		if _, ok := properties[key]; ok {
+			redacted[key] = value
+		} else {
+			redacted[key] = redactedValue
+		}
+	}
+
+	return redacted
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+)
+
+// noNamesRequestedMessage is the get_standards result for an empty standard_names array in the message mode.
+const noNamesRequestedMessage = "No standard names were requested."
+
+// emptyGetResult returns the get_standards result for an empty standard_names array and its text for the audit log.
+// ok is false in the not-found mode, where the request is served the same as one matching no standards.
+func (s *MCP) emptyGetResult() (result *mcp.CallToolResult, text string, ok bool) {
+	var standards []listedStandard
+	switch s.cfg.GetEmptyGet() {
+	case config.EmptyGetMessage:
+		text = normalizeTrailingNewline(noNamesRequestedMessage, s.cfg.IsTrailingNewlineEnabled())
+	case config.EmptyGetEmpty:
+		// An empty, not nil, list is kept in the structured output as "standards": []
+		standards = []listedStandard{}
+	case config.EmptyGetNotFound:
+		return nil, "", false
+	default:
+		return nil, "", false
+	}
+
+	output := newToolOutput(text)
+	output.Standards = standards
+
+	return &mcp.CallToolResult{
+		IsError:           false,
+		Meta:              mcp.Meta{},
+		Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: text}},
+		StructuredContent: output,
+	}, text, true
+}
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// optionalString extracts an optional string parameter from the tool input.
+// It returns an empty string if the parameter is absent.
+func optionalString(input map[string]any, key string) (string, error) {
+	raw, ok := input[key]
+	if !ok || raw == nil {
+		return "", nil
+	}
+
+	value, ok := raw.(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string", key)
+	}
+
+	return value, nil
+}
+
+// optionalBool extracts an optional boolean parameter from the tool input.
+// It returns false if the parameter is absent.
+func optionalBool(input map[string]any, key string) (bool, error) {
+	raw, ok := input[key]
+	if !ok || raw == nil {
+		return false, nil
+	}
+
+	value, ok := raw.(bool)
+	if !ok {
+		return false, fmt.Errorf("%s must be a boolean", key)
+	}
+
+	return value, nil
+}
+
+// optionalStringList extracts an optional list of strings from the tool input.
+// A single string is accepted as a list of one. It returns nil if the parameter is absent.
+func optionalStringList(input map[string]any, key string) ([]string, error) {
+	raw, ok := input[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	switch typed := raw.(type) {
+	case string:
+		return []string{typed}, nil
+	case []string:
+		return typed, nil
+	case []any:
+		values := make([]string, len(typed))
+		for i, item := range typed {
+			value, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s must be an array of strings", key)
+			}
+			values[i] = value
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("%s must be a string or an array of strings", key)
+	}
+}
+
+// optionalStringMap extracts an optional object of string values from the tool input.
+// Boolean and number values are accepted and converted to their text. It returns nil if the parameter is absent.
+func optionalStringMap(input map[string]any, key string) (map[string]string, error) {
+	raw, ok := input[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	switch typed := raw.(type) {
+	case map[string]string:
+		return typed, nil
+	case map[string]any:
+		values := make(map[string]string, len(typed))
+		for name, item := range typed {
+			switch value := item.(type) {
+			case string:
+				values[name] = value
+			case bool, float64, int:
+				values[name] = fmt.Sprint(value)
+			default:
+				return nil, fmt.Errorf("%s values must be strings, numbers or booleans", key)
+			}
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("%s must be an object", key)
+	}
+}
+
+// optionalEnum extracts an optional string parameter that must be one of the allowed values.
+// It returns an empty string if the parameter is absent.
+func optionalEnum(input map[string]any, key string, allowed ...string) (string, error) {
+	value, err := optionalString(input, key)
+	if err != nil || value == "" {
+		return value, err
+	}
+
+	if !slices.Contains(allowed, value) {
+		return "", fmt.Errorf("invalid %s: %s (must be one of: %s)", key, value, strings.Join(allowed, ", "))
+	}
+
+	return value, nil
+}
+
+// optionalNonNegativeInt extracts an optional non-negative integer parameter from the tool input.
+// JSON numbers are accepted if they have no fractional part. It reports false if the parameter is absent.
+func optionalNonNegativeInt(input map[string]any, key string) (int, bool, error) {
+	raw, ok := input[key]
+	if !ok || raw == nil {
+		return 0, false, nil
+	}
+
+	var value int
+	switch typed := raw.(type) {
+	case int:
+		value = typed
+	case int64:
+		value = int(typed)
+	case float64:
+		if typed != math.Trunc(typed) || typed > math.MaxInt32 {
+			return 0, false, fmt.Errorf("%s must be an integer", key)
+		}
+		value = int(typed)
+	default:
+		return 0, false, fmt.Errorf("%s must be an integer", key)
+	}
+
+	if value < 0 {
+		return 0, false, fmt.Errorf("%s must not be negative, got: %d", key, value)
+	}
+
+	return value, true, nil
+}
+
+// limitInputSize rejects tool calls whose serialized input exceeds the configured maximum input size
+// with an error result. The size is checked before the input is decoded, so that oversized inputs cost
+// no further processing. The audit log records the rejected call with the input size instead of the input.
+func (s *MCP) limitInputSize(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		request, ok := req.(*mcp.CallToolRequest)
+		maxBytes := s.cfg.GetMaxInputBytes()
+		if !ok || maxBytes <= 0 || request.Params == nil || len(request.Params.Arguments) <= maxBytes {
+			return next(ctx, method, req)
+		}
+
+		inputBytes := len(request.Params.Arguments)
+		err := fmt.Errorf("tool input of %d bytes exceeds the maximum input size of %d bytes", inputBytes, maxBytes)
+		s.logger.Warn("Rejected oversized tool input", "tool", request.Params.Name, "input_bytes", inputBytes,
+			"max_input_bytes", maxBytes)
+
+		metadata := newRequestMetadata(request.Session)
+		s.auditLogger.LogClientRequest(metadata.ClientID, request.Params.Name, map[string]any{"input_bytes": inputBytes})
+		s.auditLogger.LogClientResponse(metadata.ClientID, nil, err)
+
+		return newErrorResult(err), nil
+	}
+}
@@ -9,11 +9,32 @@ import (
 
 //go:generate mockgen -source=interfaces.go -destination=mocks.go -package=server
 
-// StandardLoader defines the interface for loading standards from the file system.
+// StandardLoader defines the interface for loading standards from the file system or another source.
 type StandardLoader interface {
 	// ListStandards returns a list of available standard information (name and description).
 	ListStandards(ctx context.Context) ([]domain.StandardInfo, error)
 
-	// GetStandards returns the full content of specific standards by their names.
-	GetStandards(ctx context.Context, standardNames []string) ([]domain.Standard, error)
+	// GetStandard returns the full content of a single standard by its name.
+	// It returns an error wrapping domain.ErrStandardNotFound if no standard has the name
+	// and domain.ErrStandardSkipped if the standard was skipped.
+	GetStandard(ctx context.Context, standardName string) (domain.Standard, error)
+
+	// GetStandards returns the full content of specific standards by their names, along with the requested
+	// standards that exist but were skipped, e.g. because they exceed the maximum standard size outside strict mode.
+	GetStandards(ctx context.Context, standardNames []string) ([]domain.Standard, []domain.SkippedStandard, error)
+}
+
+// CacheStatsProvider is implemented by standard loaders that cache the documents of their standards source.
+type CacheStatsProvider interface {
+	// CacheStats returns the cache statistics accumulated since the loader was created.
+	CacheStats() domain.CacheStats
+}
+
+// PathStandardLoader is implemented by standard loaders that can address standards by their file path.
+type PathStandardLoader interface {
+	// GetStandardByPath returns the full content of the standard at a slash-separated path relative to the
+	// standards source. It returns an error wrapping domain.ErrPathTraversal if the path leaves the source
+	// and domain.ErrStandardNotFound if no standard is stored at the path, or domain.ErrStandardSkipped
+	// if the standard was skipped.
+	GetStandardByPath(ctx context.Context, relPath string) (domain.Standard, error)
 }
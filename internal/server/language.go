@@ -0,0 +1,136 @@
+package server
+
+import (
+	"slices"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// groupLanguageVariants collapses language variants of a standard into a single entry.
+// The entry describes the variant preferred for the requested language, then defaultLanguage,
+// and lists all available languages. The order of first appearance is preserved.
+func groupLanguageVariants(infos []domain.StandardInfo, language, defaultLanguage string) []domain.StandardInfo {
+	names, groups := groupByName(infos, standardInfoName)
+
+	result := make([]domain.StandardInfo, 0, len(names))
+	for _, name := range names {
+		variants := groups[name]
+
+		languages := make([]string, 0, len(variants))
+		for _, variant := range variants {
+			if variant.Language != "" && !slices.Contains(languages, variant.Language) {
+				languages = append(languages, variant.Language)
+			}
+		}
+		slices.Sort(languages)
+
+		info := variants[preferredVariant(variants, standardInfoLanguage, language, defaultLanguage)]
+		if len(languages) > 0 {
+			info.Languages = languages
+		}
+
+		result = append(result, info)
+	}
+
+	return result
+}
+
+// selectLanguageVariants keeps a single language variant of each requested standard.
+// Variants of a requested name are expected to be adjacent with at most one file per language,
+// as returned by the loader, so a name requested several times is kept several times.
+// The requested language is preferred, then defaultLanguage, then the untagged standard.
+func selectLanguageVariants(standards []domain.Standard, language, defaultLanguage string) []domain.Standard {
+	result := make([]domain.Standard, 0, len(standards))
+
+	for start := 0; start < len(standards); {
+		// A repeated language starts the variants of the next occurrence of the same name
+		languages := map[string]struct{}{standards[start].Language: {}}
+		end := start + 1
+		for end < len(standards) && standards[end].Name == standards[start].Name {
+			if _, seen := languages[standards[end].Language]; seen {
+				break
+			}
+			languages[standards[end].Language] = struct{}{}
+			end++
+		}
+
+		variants := standards[start:end]
+		result = append(result, variants[preferredVariant(variants, standardLanguage, language, defaultLanguage)])
+		start = end
+	}
+
+	return result
+}
+
+// localizeInfoDescriptions replaces the descriptions of standard infos with their translations
+// to the requested language, then defaultLanguage, when the frontmatter provides them.
+func localizeInfoDescriptions(infos []domain.StandardInfo, language, defaultLanguage string) {
+	for i := range infos {
+		infos[i].Description = localizedDescription(
+			infos[i].Description, infos[i].Descriptions, language, defaultLanguage)
+	}
+}
+
+// localizeDescriptions replaces the descriptions of standards with their translations
+// to the requested language, then defaultLanguage, when the frontmatter provides them.
+func localizeDescriptions(standards []domain.Standard, language, defaultLanguage string) {
+	for i := range standards {
+		standards[i].Description = localizedDescription(
+			standards[i].Description, standards[i].Descriptions, language, defaultLanguage)
+	}
+}
+
+// localizedDescription returns the translation of a description to the first available of
+// the requested language and the default language. It falls back to the description as loaded.
+func localizedDescription(description string, translations map[string]string, language, defaultLanguage string) string {
+	for _, candidate := range []string{language, defaultLanguage} {
+		if text, ok := translations[candidate]; ok && candidate != "" {
+			return text
+		}
+	}
+
+	return description
+}
+
+// groupByName groups items by name and returns the names in order of first appearance.
+func groupByName[T any](items []T, name func(T) string) ([]string, map[string][]T) {
+	var names []string
+	groups := make(map[string][]T, len(items))
+
+	for _, item := range items {
+		key := name(item)
+		if _, exists := groups[key]; !exists {
+			names = append(names, key)
+		}
+		groups[key] = append(groups[key], item)
+	}
+
+	return names, groups
+}
+
+// preferredVariant returns the index of the variant matching the first available of:
+// the requested language, the default language, no language tag. It falls back to the first variant.
+func preferredVariant[T any](variants []T, language func(T) string, requested, defaultLanguage string) int {
+	candidates := []string{requested, defaultLanguage, ""}
+	for i, candidate := range candidates {
+		// An empty requested or default language means "no preference", not "untagged"
+		if candidate == "" && i < len(candidates)-1 {
+			continue
+		}
+
+		if index := slices.IndexFunc(variants, func(v T) bool { return language(v) == candidate }); index >= 0 {
+			return index
+		}
+	}
+
+	return 0
+}
+
+// standardInfoName returns the name of a standard info.
+func standardInfoName(info domain.StandardInfo) string { return info.Name }
+
+// standardInfoLanguage returns the language of a standard info.
+func standardInfoLanguage(info domain.StandardInfo) string { return info.Language }
+
+// standardLanguage returns the language of a standard.
+func standardLanguage(standard domain.Standard) string { return standard.Language }
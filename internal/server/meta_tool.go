@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// standardMeta is the frontmatter metadata of a standard returned by the get_standard_meta tool.
+type standardMeta struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Title       string   `json:"title,omitempty"`
+	Language    string   `json:"language,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Priority    float64  `json:"priority"`
+	ReviewBy    string   `json:"review_by,omitempty"`
+	Enabled     bool     `json:"enabled"`
+}
+
+// newStandardMeta returns the metadata of a standard, leaving out its content.
+func newStandardMeta(standard domain.Standard) standardMeta {
+	meta := standardMeta{
+		Name:        standard.Name,
+		Description: standard.Description,
+		Title:       standard.Title,
+		Language:    standard.Language,
+		Tags:        standard.Tags,
+		Priority:    standard.Priority,
+		ReviewBy:    "",
+		Enabled:     !standard.Disabled,
+	}
+	if !standard.ReviewBy.IsZero() {
+		meta.ReviewBy = standard.ReviewBy.Format(time.DateOnly)
+	}
+
+	return meta
+}
+
+// registerMetaTool registers the get_standard_meta tool with the MCP server.
+func (s *MCP) registerMetaTool() {
+	getStandardMetaInputSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"name": map[string]any{
+				"type":        "string",
+				"description": "Name of the standard to retrieve the metadata of",
+			},
+		},
+		"required": []string{"name"},
+	}
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name: "get_standard_meta",
+		Description: "Returns the frontmatter metadata of a standard as JSON: description, title, tags, priority, " +
+			"review date and whether it is enabled, without the content. " +
+			"Use it for metadata-driven decisions instead of loading the full standard with get_standards.",
+		InputSchema:  getStandardMetaInputSchema,
+		OutputSchema: toolOutputSchema("Standard metadata as JSON", outputError),
+		Meta:         mcp.Meta{},
+		Annotations:  s.readOnlyToolAnnotations("Get Standard Metadata"),
+		Title:        "Get Standard Metadata",
+	}, func(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
+		*mcp.CallToolResult, toolOutput, error,
+	) {
+		result, err := s.handleGetStandardMeta(ctx, request, input)
+		return s.toolResult(result, err, input, getStandardMetaInputSchema)
+	})
+}
+
+// handleGetStandardMeta handles the get_standard_meta tool request.
+func (s *MCP) handleGetStandardMeta(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
+	*mcp.CallToolResult,
+	error,
+) {
+	metadata := newRequestMetadata(request.Session)
+	s.auditLogger.LogClientRequest(metadata.ClientID, "get_standard_meta", input)
+
+	logger := s.requestLogger(request)
+
+	name, err := optionalString(input, "name")
+	if err == nil && name == "" {
+		err = errors.New("name is required")
+	}
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	logger.Debug("Getting standard metadata", "name", name, "client", metadata.ClientID,
+		"session_id", metadata.SessionID)
+
+	var standard domain.Standard
+	if s.allowed(name) {
+		standard, err = s.standardLoader.GetStandard(s.loaderContext(ctx, logger), name)
+	} else {
+		err = fmt.Errorf("%w: %s", domain.ErrStandardNotFound, name)
+	}
+	if errors.Is(err, domain.ErrStandardNotFound) {
+		logger.Debug("Standard not found", "name", name)
+		s.auditLogger.LogClientResponse(metadata.ClientID, nil, err)
+		return newNotFoundResult(name, err), err
+	}
+	if err != nil {
+		logger.Error("Failed to get standard metadata", "error", err)
+		return s.fail(metadata, err)
+	}
+
+	data, err := json.MarshalIndent(newStandardMeta(standard), "", "  ")
+	if err != nil {
+		err = fmt.Errorf("failed to marshal standard metadata: %w", err)
+		logger.Error("Failed to get standard metadata", "error", err)
+		return s.fail(metadata, err)
+	}
+
+	formattedResult := string(data)
+
+	s.auditLogger.LogClientResponse(metadata.ClientID, formattedResult, nil)
+	return &mcp.CallToolResult{
+		IsError:           false,
+		Meta:              mcp.Meta{},
+		Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: formattedResult}},
+		StructuredContent: newToolOutput(formattedResult),
+	}, nil
+}
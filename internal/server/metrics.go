@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"sync"
+	"sync/atomic"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// errorCodeToolError is the error code counted for failed tool calls without a typed error.
+const errorCodeToolError = "TOOL_ERROR"
+
+// usageMetrics are the cumulative usage counters of the server, safe for concurrent use.
+type usageMetrics struct {
+	standardsServed atomic.Int64
+	bytesDelivered  atomic.Int64
+
+	mu        sync.Mutex
+	toolCalls map[string]int64
+	errors    map[string]int64
+}
+
+// metricsSnapshot is the state of the usage counters reported by the server_info tool.
+type metricsSnapshot struct {
+	ToolCalls       map[string]int64 `json:"tool_calls"`
+	StandardsServed int64            `json:"standards_served"`
+	BytesDelivered  int64            `json:"bytes_delivered"`
+	Errors          map[string]int64 `json:"errors"`
+}
+
+// newUsageMetrics creates usage counters starting at zero.
+func newUsageMetrics() *usageMetrics {
+	return &usageMetrics{
+		standardsServed: atomic.Int64{},
+		bytesDelivered:  atomic.Int64{},
+		mu:              sync.Mutex{},
+		toolCalls:       make(map[string]int64),
+		errors:          make(map[string]int64),
+	}
+}
+
+// addStandardsServed counts standards returned to a client.
+func (m *usageMetrics) addStandardsServed(count int) {
+	m.standardsServed.Add(int64(count))
+}
+
+// recordToolCall counts a completed tool call, the text it delivered and, if it failed, its error code.
+func (m *usageMetrics) recordToolCall(toolName string, result *mcp.CallToolResult) {
+	code := ""
+	if result == nil {
+		code = errorCodeToolError
+	} else if result.IsError {
+		code = errorCodeOf(result)
+	} else {
+		for _, content := range result.Content {
+			if text, ok := content.(*mcp.TextContent); ok {
+				m.bytesDelivered.Add(int64(len(text.Text)))
+			}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.toolCalls[toolName]++
+	if code != "" {
+		m.errors[code]++
+	}
+}
+
+// snapshot returns a copy of the current counters.
+func (m *usageMetrics) snapshot() metricsSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return metricsSnapshot{
+		ToolCalls:       maps.Clone(m.toolCalls),
+		StandardsServed: m.standardsServed.Load(),
+		BytesDelivered:  m.bytesDelivered.Load(),
+		Errors:          maps.Clone(m.errors),
+	}
+}
+
+// errorCodeOf returns the code of the typed error of a failed tool result, errorCodeToolError if it has none.
+// The SDK hands the structured content over as marshaled JSON, handlers as a toolOutput.
+func errorCodeOf(result *mcp.CallToolResult) string {
+	var output toolOutput
+	switch content := result.StructuredContent.(type) {
+	case toolOutput:
+		output = content
+	case json.RawMessage:
+		if err := json.Unmarshal(content, &output); err != nil {
+			return errorCodeToolError
+		}
+	}
+
+	if output.Error == nil || output.Error.Code == "" {
+		return errorCodeToolError
+	}
+	return output.Error.Code
+}
+
+// countUsage is a receiving middleware counting the tool calls that reach the server.
+func (s *MCP) countUsage(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		result, err := next(ctx, method, req)
+
+		request, ok := req.(*mcp.CallToolRequest)
+		if !ok {
+			return result, err
+		}
+
+		toolResult, _ := result.(*mcp.CallToolResult)
+		if err != nil {
+			toolResult = nil
+		}
+		s.metrics.recordToolCall(request.Params.Name, toolResult)
+
+		return result, err
+	}
+}
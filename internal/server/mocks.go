@@ -41,13 +41,29 @@ func (m *MockStandardLoader) EXPECT() *MockStandardLoaderMockRecorder {
 	return m.recorder
 }
 
+// GetStandard mocks base method.
+func (m *MockStandardLoader) GetStandard(ctx context.Context, standardName string) (domain.Standard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStandard", ctx, standardName)
+	ret0, _ := ret[0].(domain.Standard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStandard indicates an expected call of GetStandard.
+func (mr *MockStandardLoaderMockRecorder) GetStandard(ctx, standardName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStandard", reflect.TypeOf((*MockStandardLoader)(nil).GetStandard), ctx, standardName)
+}
+
 // GetStandards mocks base method.
-func (m *MockStandardLoader) GetStandards(ctx context.Context, standardNames []string) ([]domain.Standard, error) {
+func (m *MockStandardLoader) GetStandards(ctx context.Context, standardNames []string) ([]domain.Standard, []domain.SkippedStandard, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStandards", ctx, standardNames)
 	ret0, _ := ret[0].([]domain.Standard)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].([]domain.SkippedStandard)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetStandards indicates an expected call of GetStandards.
@@ -70,3 +86,80 @@ func (mr *MockStandardLoaderMockRecorder) ListStandards(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStandards", reflect.TypeOf((*MockStandardLoader)(nil).ListStandards), ctx)
 }
+
+// MockCacheStatsProvider is a mock of CacheStatsProvider interface.
+type MockCacheStatsProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheStatsProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockCacheStatsProviderMockRecorder is the mock recorder for MockCacheStatsProvider.
+type MockCacheStatsProviderMockRecorder struct {
+	mock *MockCacheStatsProvider
+}
+
+// NewMockCacheStatsProvider creates a new mock instance.
+func NewMockCacheStatsProvider(ctrl *gomock.Controller) *MockCacheStatsProvider {
+	mock := &MockCacheStatsProvider{ctrl: ctrl}
+	mock.recorder = &MockCacheStatsProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCacheStatsProvider) EXPECT() *MockCacheStatsProviderMockRecorder {
+	return m.recorder
+}
+
+// CacheStats mocks base method.
+func (m *MockCacheStatsProvider) CacheStats() domain.CacheStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CacheStats")
+	ret0, _ := ret[0].(domain.CacheStats)
+	return ret0
+}
+
+// CacheStats indicates an expected call of CacheStats.
+func (mr *MockCacheStatsProviderMockRecorder) CacheStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheStats", reflect.TypeOf((*MockCacheStatsProvider)(nil).CacheStats))
+}
+
+// MockPathStandardLoader is a mock of PathStandardLoader interface.
+type MockPathStandardLoader struct {
+	ctrl     *gomock.Controller
+	recorder *MockPathStandardLoaderMockRecorder
+	isgomock struct{}
+}
+
+// MockPathStandardLoaderMockRecorder is the mock recorder for MockPathStandardLoader.
+type MockPathStandardLoaderMockRecorder struct {
+	mock *MockPathStandardLoader
+}
+
+// NewMockPathStandardLoader creates a new mock instance.
+func NewMockPathStandardLoader(ctrl *gomock.Controller) *MockPathStandardLoader {
+	mock := &MockPathStandardLoader{ctrl: ctrl}
+	mock.recorder = &MockPathStandardLoaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPathStandardLoader) EXPECT() *MockPathStandardLoaderMockRecorder {
+	return m.recorder
+}
+
+// GetStandardByPath mocks base method.
+func (m *MockPathStandardLoader) GetStandardByPath(ctx context.Context, relPath string) (domain.Standard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStandardByPath", ctx, relPath)
+	ret0, _ := ret[0].(domain.Standard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStandardByPath indicates an expected call of GetStandardByPath.
+func (mr *MockPathStandardLoaderMockRecorder) GetStandardByPath(ctx, relPath any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStandardByPath", reflect.TypeOf((*MockPathStandardLoader)(nil).GetStandardByPath), ctx, relPath)
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// overviewFlag marks the overview of the standards in the list_standards output.
+const overviewFlag = "[OVERVIEW]"
+
+// isOverview reports whether the standard is an overview: a README.md or _overview.md file, or a language
+// variant of one, at the top level of the standards source. File names are compared case-insensitively.
+func isOverview(info domain.StandardInfo) bool {
+	if info.Path == "" || strings.Contains(info.Path, "/") {
+		return false
+	}
+
+	name, ok := strings.CutSuffix(strings.ToLower(path.Base(info.Path)), ".md")
+	if !ok {
+		return false
+	}
+	if base, tag, found := strings.Cut(name, "."); found && config.IsLanguageTag(tag) {
+		name = base
+	}
+
+	return name == "readme" || name == "_overview"
+}
+
+// overviewFirst moves overviews to the front of the list, keeping the order of the other standards.
+func overviewFirst(infos []domain.StandardInfo) {
+	slices.SortStableFunc(infos, func(a, b domain.StandardInfo) int {
+		switch {
+		case isOverview(a) && !isOverview(b):
+			return -1
+		case !isOverview(a) && isOverview(b):
+			return 1
+		default:
+			return 0
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// registerPathTool registers the get_standards_by_path tool with the MCP server.
+func (s *MCP) registerPathTool() {
+	getStandardsByPathInputSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"paths": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "string",
+				},
+				"description": "File paths of the standards relative to the standards folder, " +
+					"as listed by list_standards, e.g. go/testing.md",
+			},
+		},
+		"required": []string{"paths"},
+	}
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name: "get_standards_by_path",
+		Description: "Returns the full content of standards by their file paths relative to the standards folder. " +
+			"Use it when you know where a standard is stored rather than its name.",
+		InputSchema:  getStandardsByPathInputSchema,
+		OutputSchema: toolOutputSchema("Standard content", outputWarnings),
+		Meta:         mcp.Meta{},
+		Annotations:  s.readOnlyToolAnnotations("Get Standards by Path"),
+		Title:        "Get Standards by Path",
+	}, func(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
+		*mcp.CallToolResult, toolOutput, error,
+	) {
+		result, err := s.handleGetStandardsByPath(ctx, request, input)
+		return s.toolResult(result, err, input, getStandardsByPathInputSchema)
+	})
+}
+
+// handleGetStandardsByPath handles the get_standards_by_path tool request.
+// Paths without a standard are reported as warnings, paths leaving the standards folder fail the request.
+func (s *MCP) handleGetStandardsByPath(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
+	*mcp.CallToolResult,
+	error,
+) {
+	metadata := newRequestMetadata(request.Session)
+	s.auditLogger.LogClientRequest(metadata.ClientID, "get_standards_by_path", input)
+
+	logger := s.requestLogger(request)
+
+	paths, err := optionalStringList(input, "paths")
+	if err == nil && len(paths) == 0 {
+		err = errors.New("paths is required")
+	}
+	if err == nil {
+		// Cap the per-request cost the same as for names
+		if maxPaths := s.cfg.GetMaxGetNames(); maxPaths > 0 && len(paths) > maxPaths {
+			err = fmt.Errorf("too many paths: %d (maximum is %d per call)", len(paths), maxPaths)
+		}
+	}
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	pathLoader, ok := s.standardLoader.(PathStandardLoader)
+	if !ok {
+		err = errors.New("the standards source does not support paths")
+		return s.fail(metadata, err)
+	}
+
+	logger.Debug("Getting standards by path", "paths", paths, "client", metadata.ClientID,
+		"session_id", metadata.SessionID)
+
+	loaderCtx := s.loaderContext(ctx, logger)
+	domainResult := make([]domain.Standard, 0, len(paths))
+	var warnings []string
+	for _, path := range paths {
+		standard, err := pathLoader.GetStandardByPath(loaderCtx, path)
+		// Disabled standards and standards missing from the allowlist are not served, the same as for names
+		if errors.Is(err, domain.ErrStandardNotFound) || (err == nil && (standard.Disabled || !s.allowed(standard.Name))) {
+			warnings = append(warnings, fmt.Sprintf("standard at path %s not found", path))
+			continue
+		}
+		if errors.Is(err, domain.ErrStandardSkipped) {
+			warnings = append(warnings, fmt.Sprintf("standard at path %s skipped: %s", path, domain.ErrStandardSkipped))
+			continue
+		}
+		if err != nil {
+			logger.Error("Failed to get standards by path", "path", path, "error", err)
+			return s.fail(metadata, err)
+		}
+		domainResult = append(domainResult, standard)
+	}
+
+	localizeDescriptions(domainResult, "", s.cfg.GetDefaultLanguage())
+	domainResult = expandTemplateVars(domainResult, s.cfg.GetTemplateVars())
+
+	logger.Debug("Retrieved standards by path", "requested", len(paths), "found", len(domainResult))
+	s.metrics.addStandardsServed(len(domainResult))
+
+	formattedResult := formatStandards(domainResult, s.contentWrapper(), false)
+	formattedResult = normalizeTrailingNewline(formattedResult, s.cfg.IsTrailingNewlineEnabled())
+
+	s.auditLogger.LogClientResponse(metadata.ClientID, formattedResult, nil)
+	output := newToolOutput(formattedResult)
+	output.Warnings = warnings
+
+	return &mcp.CallToolResult{
+		IsError:           false,
+		Meta:              mcp.Meta{},
+		Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: formattedResult}},
+		StructuredContent: output,
+	}, nil
+}
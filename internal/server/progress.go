@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/n-r-w/agent-standards-mcp/internal/shared"
+)
+
+// progressStep is the number of processed items between progress notifications.
+const progressStep = 10
+
+// requestProgress returns a context that reports the progress of long operations to the client.
+// Progress is reported only when the client sent a progress token with the request.
+func (s *MCP) requestProgress(ctx context.Context, request *mcp.CallToolRequest) context.Context {
+	if request == nil || request.Session == nil || request.Params == nil {
+		return ctx
+	}
+
+	token := request.Params.GetProgressToken()
+	if token == nil {
+		return ctx
+	}
+
+	return shared.WithProgress(ctx, func(done, total int) {
+		// Notify periodically and once the operation is complete
+		if done%progressStep != 0 && done != total {
+			return
+		}
+
+		err := request.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+			Meta:          mcp.Meta{},
+			ProgressToken: token,
+			Message:       "",
+			Progress:      float64(done),
+			Total:         float64(total),
+		})
+		if err != nil {
+			s.logger.Warn("Failed to send progress notification", "error", err)
+		}
+	})
+}
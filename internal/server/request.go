@@ -0,0 +1,43 @@
+package server
+
+import (
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// defaultClientID identifies clients that did not report their name during initialization.
+const defaultClientID = "mcp-client"
+
+// requestMetadata describes the client and session a request belongs to.
+type requestMetadata struct {
+	// ClientID is the client name reported during initialization, or defaultClientID.
+	ClientID string
+	// ClientVersion is the client version reported during initialization, if any.
+	ClientVersion string
+	// SessionID is the MCP session identifier, empty for transports without sessions.
+	SessionID string
+}
+
+// newRequestMetadata extracts the request metadata from the session a request was received on.
+// Missing sessions and client information result in default values.
+func newRequestMetadata(session *mcp.ServerSession) requestMetadata {
+	metadata := requestMetadata{
+		ClientID:      defaultClientID,
+		ClientVersion: "",
+		SessionID:     "",
+	}
+
+	if session == nil {
+		return metadata
+	}
+
+	metadata.SessionID = session.ID()
+
+	if params := session.InitializeParams(); params != nil && params.ClientInfo != nil {
+		if params.ClientInfo.Name != "" {
+			metadata.ClientID = params.ClientInfo.Name
+		}
+		metadata.ClientVersion = params.ClientInfo.Version
+	}
+
+	return metadata
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/n-r-w/agent-standards-mcp/internal/standards"
+)
+
+// frontmatterSchemaMIMEType is the MIME type of the frontmatter JSON Schema resource.
+const frontmatterSchemaMIMEType = "application/schema+json"
+
+// RegisterResources registers the schema://frontmatter resource with the MCP server.
+func (s *MCP) RegisterResources() error {
+	s.logger.Info("Registering MCP resources")
+
+	schema, err := json.MarshalIndent(standards.FrontmatterSchema(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal frontmatter schema: %w", err)
+	}
+
+	s.server.AddResource(&mcp.Resource{
+		Meta:        mcp.Meta{},
+		Annotations: nil,
+		Description: "JSON Schema describing the supported frontmatter fields of standard files",
+		MIMEType:    frontmatterSchemaMIMEType,
+		Name:        "frontmatter-schema",
+		Size:        int64(len(schema)),
+		Title:       "Standard Frontmatter Schema",
+		URI:         standards.FrontmatterSchemaID,
+	}, s.handleReadFrontmatterSchema(string(schema)))
+
+	return nil
+}
+
+// handleReadFrontmatterSchema returns the handler serving the frontmatter JSON Schema resource.
+func (s *MCP) handleReadFrontmatterSchema(schema string) mcp.ResourceHandler {
+	return func(_ context.Context, request *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		metadata := newRequestMetadata(request.Session)
+		s.auditLogger.LogClientRequest(metadata.ClientID, "read_resource", map[string]any{"uri": request.Params.URI})
+
+		s.auditLogger.LogClientResponse(metadata.ClientID, schema, nil)
+
+		return &mcp.ReadResourceResult{
+			Meta: mcp.Meta{},
+			Contents: []*mcp.ResourceContents{{
+				Meta:     mcp.Meta{},
+				URI:      standards.FrontmatterSchemaID,
+				MIMEType: frontmatterSchemaMIMEType,
+				Text:     schema,
+				Blob:     nil,
+			}},
+		}, nil
+	}
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// SelfTestCheck is the outcome of a single self-test step. Err is nil when the step passed.
+type SelfTestCheck struct {
+	Name string
+	Err  error
+}
+
+// SelfTestReport is the outcome of SelfTest, one check per step in execution order.
+type SelfTestReport struct {
+	Checks []SelfTestCheck
+}
+
+// Passed reports whether all checks passed.
+func (r SelfTestReport) Passed() bool {
+	return !slices.ContainsFunc(r.Checks, func(check SelfTestCheck) bool { return check.Err != nil })
+}
+
+// String formats the report as one line per check followed by the overall result.
+func (r SelfTestReport) String() string {
+	var builder strings.Builder
+
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			fmt.Fprintf(&builder, "FAIL %s: %v\n", check.Name, check.Err)
+		} else {
+			fmt.Fprintf(&builder, "PASS %s\n", check.Name)
+		}
+	}
+
+	if r.Passed() {
+		builder.WriteString("Self-test passed")
+	} else {
+		builder.WriteString("Self-test failed")
+	}
+
+	return builder.String()
+}
+
+// SelfTest checks the standards source end to end without an MCP client: it lists the standards,
+// loads the first one and verifies that it matches its listing. Steps after a failed one are skipped.
+func (s *MCP) SelfTest(ctx context.Context) SelfTestReport {
+	var report SelfTestReport
+
+	infos, err := s.standardLoader.ListStandards(ctx)
+	if err == nil && len(infos) == 0 {
+		err = errors.New("no standards found, check the standards source configuration")
+	}
+	report.Checks = append(report.Checks, SelfTestCheck{Name: "list standards", Err: err})
+	if err != nil {
+		return report
+	}
+
+	info := infos[0]
+	loaded, _, err := s.standardLoader.GetStandards(ctx, []string{info.Name})
+	if err != nil {
+		err = fmt.Errorf("failed to get standard: %w", err)
+	}
+	report.Checks = append(report.Checks, SelfTestCheck{Name: "get standard " + info.Name, Err: err})
+	if err != nil {
+		return report
+	}
+
+	report.Checks = append(report.Checks, SelfTestCheck{
+		Name: "round trip " + info.Name,
+		Err:  checkRoundTrip(info, loaded),
+	})
+
+	return report
+}
+
+// checkRoundTrip verifies that the loaded standards contain the listed standard with the same description.
+func checkRoundTrip(info domain.StandardInfo, loaded []domain.Standard) error {
+	index := slices.IndexFunc(loaded, func(standard domain.Standard) bool {
+		return standard.Name == info.Name && standard.Language == info.Language
+	})
+	if index < 0 {
+		return errors.New("listed standard was not returned")
+	}
+
+	standard := loaded[index]
+	if standard.Description != info.Description {
+		return fmt.Errorf("description %q does not match the listed %q", standard.Description, info.Description)
+	}
+	if strings.TrimSpace(standard.Content) == "" {
+		return errors.New("content is empty")
+	}
+
+	return nil
+}
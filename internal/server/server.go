@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/n-r-w/agent-standards-mcp/internal/config"
@@ -14,21 +16,38 @@ import (
 	"github.com/n-r-w/agent-standards-mcp/internal/shared"
 )
 
+const (
+	// staleFlag marks standards past their review date in the list_standards output.
+	staleFlag = "[STALE]"
+	// truncatedListNote tells the client that list_standards did not return all standards.
+	truncatedListNote = "Showing %d of %d standards. Call list_standards with a higher limit to see more."
+	// tocHeading starts the table of contents of the get_standards output.
+	tocHeading = "Contents:"
+	// serverName is the name the server reports to clients.
+	serverName = "agent-standards-mcp"
+)
+
 // MCP implements the Server interface using the MCP Go SDK.
 type MCP struct {
 	cfg            *config.Config
 	logger         shared.Logger
 	auditLogger    shared.AuditLogger
 	standardLoader StandardLoader
+	buildInfo      BuildInfo
 	server         *mcp.Server
+	metrics        *usageMetrics
+	// stopped is closed by Stop to end the background work of the server.
+	stopped  chan struct{}
+	stopOnce sync.Once
 }
 
-// New creates a new MCP server instance.
+// New creates a new MCP server instance. The build info is reported to clients as the server version.
 func New(
 	cfg *config.Config,
 	logger shared.Logger,
 	auditLogger shared.AuditLogger,
 	standardLoader StandardLoader,
+	buildInfo BuildInfo,
 ) (*MCP, error) {
 	if cfg == nil {
 		return nil, errors.New("configuration cannot be nil")
@@ -42,8 +61,8 @@ func New(
 
 	// Create MCP server instance
 	server := mcp.NewServer(&mcp.Implementation{
-		Name:    "agent-standards-mcp",
-		Version: "1.0.0",
+		Name:    serverName,
+		Version: buildInfo.Version,
 		Title:   "Agent Standards MCP Server",
 	}, &mcp.ServerOptions{
 		Instructions:                prompt.SystemPrompt(),
@@ -62,18 +81,28 @@ func New(
 		InitializedHandler:          nil,
 	})
 
-	return &MCP{
+	s := &MCP{
 		cfg:            cfg,
 		logger:         logger,
 		auditLogger:    auditLogger,
 		standardLoader: standardLoader,
+		buildInfo:      buildInfo,
 		server:         server,
-	}, nil
+		metrics:        newUsageMetrics(),
+		stopped:        make(chan struct{}),
+		stopOnce:       sync.Once{},
+	}
+	// Oversized inputs are rejected inside the usage count, so that they are counted as errors
+	server.AddReceivingMiddleware(s.countUsage, s.limitInputSize, s.limitRequestDuration)
+
+	return s, nil
 }
 
 // Start starts the MCP server with STDIO transport.
-func (s *MCP) Start(_ context.Context) error {
+func (s *MCP) Start(ctx context.Context) error {
 	s.logger.Info("Starting MCP server")
+	s.prewarm(ctx)
+	s.startCacheStats(ctx)
 
 	// Create STDIO transport for MCP communication
 	transport := &mcp.StdioTransport{}
@@ -82,9 +111,32 @@ func (s *MCP) Start(_ context.Context) error {
 	return s.server.Run(context.Background(), transport)
 }
 
-// Stop gracefully stops the MCP server.
+// CheckStandards verifies that standards are available when the configuration requires them.
+// It lets a misconfigured deployment fail at startup instead of serving an empty set.
+func (s *MCP) CheckStandards(ctx context.Context) error {
+	if !s.cfg.IsStandardsRequired() {
+		return nil
+	}
+
+	infos, err := s.standardLoader.ListStandards(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list standards: %w", err)
+	}
+
+	if len(infos) == 0 {
+		return errors.New("no standards found, check the standards source configuration " +
+			"or unset AGENT_STANDARDS_MCP_REQUIRE_STANDARDS")
+	}
+
+	s.logger.Info("Standards available", "count", len(infos))
+
+	return nil
+}
+
+// Stop gracefully stops the MCP server and its background work, such as logging cache statistics.
 func (s *MCP) Stop(_ context.Context) error {
 	s.logger.Info("Stopping MCP server")
+	s.stopOnce.Do(func() { close(s.stopped) })
 
 	// MCP server doesn't have explicit Close method in this SDK
 	// The context cancellation in Run will handle cleanup
@@ -101,21 +153,126 @@ func (s *MCP) GetMCPServer() *mcp.Server {
 // This method should only be used in integration tests.
 func (s *MCP) StartWithTransport(ctx context.Context, transport mcp.Transport) error {
 	s.logger.Info("Starting MCP server with custom transport")
+	s.prewarm(ctx)
 	return s.server.Run(ctx, transport)
 }
 
-// formatStandardInfo formats a single StandardInfo as plain text
-func formatStandardInfo(info domain.StandardInfo) string {
-	return fmt.Sprintf("%s: %s", info.Name, info.Description)
+// prewarm lists the standards once before accepting requests when enabled,
+// so that the first client call is served from the loader cache.
+// A failed pre-warm is not fatal, the standards are loaded again on the first call.
+func (s *MCP) prewarm(ctx context.Context) {
+	if !s.cfg.IsPrewarmEnabled() {
+		return
+	}
+
+	started := time.Now()
+	infos, err := s.standardLoader.ListStandards(ctx)
+	if err != nil {
+		s.logger.Warn("Failed to pre-warm standards", "error", err)
+		return
+	}
+
+	s.logger.Info("Pre-warmed standards", "count", len(infos), "duration", time.Since(started))
+}
+
+// newErrorResult creates a tool result that reports the error as plain text.
+func newErrorResult(err error) *mcp.CallToolResult {
+	return &mcp.CallToolResult{
+		IsError:           true,
+		Meta:              mcp.Meta{},
+		Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: err.Error()}},
+		StructuredContent: newToolOutput(err.Error()),
+	}
+}
+
+// fail records the failed call in the audit log and returns err as a tool error.
+// Every handler error path after LogClientRequest must go through it.
+func (s *MCP) fail(metadata requestMetadata, err error) (*mcp.CallToolResult, error) {
+	s.auditLogger.LogClientResponse(metadata.ClientID, nil, err)
+	return newErrorResult(err), err
+}
+
+// noResultsPrompt returns the guidance shown when a filtered request matches no standards.
+func (s *MCP) noResultsPrompt() string {
+	if configured := s.cfg.GetNoResultsPrompt(); configured != "" {
+		return configured
+	}
+	return prompt.NoResultsPrompt()
+}
+
+// toolDescription returns the configured tool description, falling back to the built-in one.
+func toolDescription(configured, builtin string) string {
+	if configured != "" {
+		return configured
+	}
+	return builtin
+}
+
+// resultText returns the plain text of a tool result, used as the structured "result" output.
+func resultText(result *mcp.CallToolResult) string {
+	if structured, ok := result.StructuredContent.(toolOutput); ok {
+		return structured.Result
+	}
+
+	// Fall back to the first text content
+	if len(result.Content) > 0 {
+		if textContent, ok := result.Content[0].(*mcp.TextContent); ok {
+			return textContent.Text
+		}
+	}
+
+	return ""
+}
+
+// formatStandardInfo formats a single StandardInfo as plain text.
+// Standards past their review date at the given time are flagged as stale.
+// Standards without a description are shown with defaultDescription, names are displayed with displayNames.
+func formatStandardInfo(
+	info domain.StandardInfo, now time.Time, defaultDescription string, displayNames config.DisplayNames,
+) string {
+	var builder strings.Builder
+
+	builder.WriteString(displayName(info.Name, displayNames))
+	if len(info.Languages) > 0 {
+		builder.WriteString(" [" + strings.Join(info.Languages, ", ") + "]")
+	}
+	if isOverview(info) {
+		builder.WriteString(" " + overviewFlag)
+	}
+	if isStale(info, now) {
+		builder.WriteString(" " + staleFlag)
+	}
+	if info.Disabled {
+		builder.WriteString(" " + disabledFlag)
+	}
+
+	description := info.Description
+	if description == "" {
+		description = defaultDescription
+	}
+
+	return fmt.Sprintf("%s: %s", builder.String(), description)
+}
+
+// isStale reports whether the standard is past its review date at the given time.
+func isStale(info domain.StandardInfo, now time.Time) bool {
+	return !info.ReviewBy.IsZero() && now.After(info.ReviewBy)
 }
 
-// formatStandard formats a single Standard as plain text with content
-func formatStandard(standard domain.Standard) string {
-	return fmt.Sprintf("## %s: %s\n```md\n%s\n```", standard.Name, standard.Description, standard.Content)
+// formatStandard formats a single Standard as plain text with content.
+// A configured wrapper replaces the built-in heading and code fence.
+func formatStandard(standard domain.Standard, wrapper contentWrapper) string {
+	if !wrapper.isDefault() {
+		return wrapper.wrap(standard)
+	}
+
+	return fmt.Sprintf("## %s: %s\n```md\n%s\n```", standard.Name, wrapper.description(standard), standard.Content)
 }
 
 // formatStandardInfos formats multiple StandardInfo objects as plain text
-func formatStandardInfos(infos []domain.StandardInfo) string {
+func formatStandardInfos(
+	infos []domain.StandardInfo, now time.Time, defaultDescription string, displayNames config.DisplayNames,
+) string {
 	if len(infos) == 0 {
 		return "No standards found."
 	}
@@ -131,14 +288,82 @@ func formatStandardInfos(infos []domain.StandardInfo) string {
 		if i > 0 {
 			builder.WriteString("\n")
 		}
-		builder.WriteString(formatStandardInfo(info))
+		builder.WriteString(formatStandardInfo(info, now, defaultDescription, displayNames))
+	}
+
+	return builder.String()
+}
+
+// annotatedStandardContents returns the standards as separate content blocks, each annotated
+// with the standard priority so that clients can rank them. The first block holds the instructions,
+// followed by the table of contents when includeTOC is set.
+func annotatedStandardContents(standards []domain.Standard, wrapper contentWrapper, includeTOC bool) []mcp.Content {
+	contents := make([]mcp.Content, 0, len(standards)+2)
+	contents = append(contents, &mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: prompt.FollowStandardsPrompt()})
+	if includeTOC {
+		contents = append(contents, &mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: formatTOC(standards)})
+	}
+
+	for _, standard := range standards {
+		contents = append(contents, &mcp.TextContent{
+			Meta: mcp.Meta{},
+			Annotations: &mcp.Annotations{
+				Audience:     []mcp.Role{"assistant"},
+				LastModified: "",
+				Priority:     standard.Priority,
+			},
+			Text: formatStandard(standard, wrapper),
+		})
+	}
+
+	return contents
+}
+
+// formatNoResults formats the empty result of a filtered request followed by guidance to broaden it
+func formatNoResults(guidance string) string {
+	return "No standards found.\n\n" + guidance
+}
+
+// normalizeTrailingNewline trims trailing whitespace from a formatted result and, with trailingNewline,
+// ends it with a single newline, so that results end the same regardless of the content of the last standard.
+func normalizeTrailingNewline(text string, trailingNewline bool) string {
+	text = strings.TrimRight(text, " \t\r\n")
+	if trailingNewline {
+		text += "\n"
+	}
+
+	return text
+}
+
+// formatStandardNames formats the names of multiple StandardInfo objects, one per line
+func formatStandardNames(infos []domain.StandardInfo) string {
+	if len(infos) == 0 {
+		return "No standards found."
+	}
+
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, info.Name)
+	}
+
+	return strings.Join(names, "\n")
+}
+
+// formatTOC formats the table of contents of the standards: a numbered list of their names in output order.
+func formatTOC(standards []domain.Standard) string {
+	var builder strings.Builder
+
+	builder.WriteString(tocHeading)
+	for i, standard := range standards {
+		fmt.Fprintf(&builder, "\n%d. %s", i+1, standard.Name)
 	}
 
 	return builder.String()
 }
 
-// formatStandards formats multiple Standard objects as plain text
-func formatStandards(standards []domain.Standard) string {
+// formatStandards formats multiple Standard objects as plain text.
+// With includeTOC, the table of contents precedes the standards.
+func formatStandards(standards []domain.Standard, wrapper contentWrapper, includeTOC bool) string {
 	if len(standards) == 0 {
 		return "No standards found."
 	}
@@ -146,59 +371,84 @@ func formatStandards(standards []domain.Standard) string {
 	var builder strings.Builder
 
 	builder.WriteString(prompt.FollowStandardsPrompt() + "\n\n")
+	if includeTOC {
+		builder.WriteString(formatTOC(standards) + "\n\n------\n\n")
+	}
 
 	for i, standard := range standards {
 		if i > 0 {
 			builder.WriteString("\n\n------\n\n")
 		}
-		builder.WriteString(formatStandard(standard))
+		builder.WriteString(formatStandard(standard, wrapper))
 	}
 	return builder.String()
 }
 
-// RegisterTools registers the list_standards and get_standards tools with the MCP server.
+// RegisterTools registers the list_standards, get_standards, get_standard_meta, list_tags and server_info
+// tools with the MCP server.
+// The get_standards_by_path tool is registered only when the standard loader supports paths,
+// the get_config tool only when enabled in the configuration.
 func (s *MCP) RegisterTools() error {
 	s.logger.Info("Registering MCP tools")
 
 	// Register list_standards tool
 	listStandardsInputSchema := map[string]any{
-		"type":       "object",
-		"properties": map[string]any{},
-	}
-
-	listStandardsOutputSchema := map[string]any{
 		"type": "object",
 		"properties": map[string]any{
-			"result": map[string]any{
-				"type":        "string",
-				"description": "{Standard name}: {standard description}",
+			"sort": map[string]any{
+				"type": "string",
+				"enum": []string{sortByName, sortByPath, sortByOrder, sortByReviewBy},
+				"description": "Optional ordering of the result: 'name' orders by standard name, " +
+					"'path' orders by relative path (directory first, then file name), " +
+					"'order' orders by ascending frontmatter order (standards without one last, then by name), " +
+					"'review_by' orders by the soonest review date (standards without one last, then by name)",
+			},
+			"limit": map[string]any{
+				"type":        "integer",
+				"minimum":     0,
+				"description": "Optional maximum number of standards to return, 0 means unlimited",
+			},
+			"names_only": map[string]any{
+				"type":        "boolean",
+				"description": "Optional flag to return only standard names, one per line, without descriptions",
 			},
+			"include_disabled": map[string]any{
+				"type":        "boolean",
+				"description": "Optional flag to also list standards disabled by their frontmatter",
+			},
+			"tags": map[string]any{
+				"type":        []string{"array", "string"},
+				"items":       map[string]any{"type": "string"},
+				"description": "Optional tags to filter standards by, compared case-insensitively",
+			},
+			"tag_match": map[string]any{
+				"type": "string",
+				"enum": []string{tagMatchAny, tagMatchAll},
+				"description": "Optional tag matching mode: 'any' (default) keeps standards with at least one " +
+					"of the tags, 'all' keeps standards with all of them",
+			},
+			"lang": map[string]any{
+				"type": "string",
+				"description": "Optional two-letter language of the descriptions and standard variants to list, " +
+					"e.g. 'ru'. Falls back to the default language when a standard has no such variant",
+			},
+			"context": contextSchema(),
 		},
 	}
 
 	mcp.AddTool(s.server, &mcp.Tool{
 		Name:         "list_standards",
-		Description:  prompt.ListStandardsPrompt(),
+		Description:  toolDescription(s.cfg.GetListDescription(), prompt.ListStandardsPrompt()),
 		InputSchema:  listStandardsInputSchema,
-		OutputSchema: listStandardsOutputSchema,
+		OutputSchema: toolOutputSchema("{Standard name}: {standard description}", outputWarnings, outputStandards),
 		Meta:         mcp.Meta{},
-		Annotations:  nil,
+		Annotations:  s.readOnlyToolAnnotations("List Standards"),
 		Title:        "List Standards",
 	}, func(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
-		*mcp.CallToolResult, map[string]string, error,
+		*mcp.CallToolResult, toolOutput, error,
 	) {
 		result, err := s.handleListStandards(ctx, request, input)
-		if err != nil {
-			return result, nil, err
-		}
-		// Extract text content from the result
-		var textResult string
-		if len(result.Content) > 0 {
-			if textContent, ok := result.Content[0].(*mcp.TextContent); ok {
-				textResult = textContent.Text
-			}
-		}
-		return result, map[string]string{"result": textResult}, nil
+		return s.toolResult(result, err, input, listStandardsInputSchema)
 	})
 
 	// Register get_standards tool
@@ -206,102 +456,227 @@ func (s *MCP) RegisterTools() error {
 		"type": "object",
 		"properties": map[string]any{
 			"standard_names": map[string]any{
-				"type": "array",
+				"type": []string{"array", "string"},
 				"items": map[string]any{
 					"type": "string",
 				},
-				"description": "List of standard names to retrieve",
+				"description": "List of standard names to retrieve. A single name is also accepted",
 			},
-		},
-		"required": []string{"standard_names"},
-	}
-
-	getStandardsOutputSchema := map[string]any{
-		"type": "object",
-		"properties": map[string]any{
-			"result": map[string]any{
-				"type":        "string",
-				"description": "Standard content",
+			"lang": map[string]any{
+				"type": "string",
+				"description": "Optional two-letter language of the standard variants to retrieve, e.g. 'ru'. " +
+					"Falls back to the default language when a standard has no such variant",
 			},
+			"include_disabled": map[string]any{
+				"type":        "boolean",
+				"description": "Optional flag to also return standards disabled by their frontmatter",
+			},
+			"raw": map[string]any{
+				"type": "boolean",
+				"description": "Optional flag to return the content as authored, without newline normalization, " +
+					"comment stripping or heading demotion",
+			},
+			"sort": map[string]any{
+				"type": "string",
+				"enum": []string{sortByPriority},
+				"description": "Optional ordering of the result: 'priority' orders by descending frontmatter priority, " +
+					"then by name. By default standards are returned in the requested order",
+			},
+			"max_bytes": map[string]any{
+				"type":    "integer",
+				"minimum": 0,
+				"description": "Optional maximum total size of standard contents in bytes, or in estimated tokens " +
+					"when the server counts tokens, to fit the response into your context window. " +
+					"Standards that do not fit are omitted and listed in a note. " +
+					"It can only lower the server limit, 0 means the server limit",
+			},
+			"include_toc": map[string]any{
+				"type":        "boolean",
+				"description": "Optional flag to prepend a numbered list of the returned standard names",
+			},
+			"format": map[string]any{
+				"type": "string",
+				"enum": []string{formatDocument},
+				"description": "Optional output format: 'document' returns a single markdown document with a table " +
+					"of contents linking to each standard under its own heading, instead of code blocks",
+			},
+			"show_size": map[string]any{
+				"type":        "boolean",
+				"description": "Optional flag to follow the description of each standard with its line and byte count",
+			},
+			"include_frontmatter": map[string]any{
+				"type": "boolean",
+				"description": "Optional flag to return the content preceded by its YAML frontmatter block, " +
+					"with raw the file as authored",
+			},
+			"context": contextSchema(),
 		},
+		"required": []string{"standard_names"},
 	}
 
 	mcp.AddTool(s.server, &mcp.Tool{
 		Name:         "get_standards",
-		Description:  prompt.GetStandardsPrompt(),
+		Description:  toolDescription(s.cfg.GetGetDescription(), prompt.GetStandardsPrompt()),
 		InputSchema:  getStandardsInputSchema,
-		OutputSchema: getStandardsOutputSchema,
+		OutputSchema: toolOutputSchema("Standard content", outputWarnings, outputStandards),
 		Meta:         mcp.Meta{},
-		Annotations:  nil,
+		Annotations:  s.readOnlyToolAnnotations("Get Standards"),
 		Title:        "Get Standards",
 	}, func(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
-		*mcp.CallToolResult, map[string]string, error,
+		*mcp.CallToolResult, toolOutput, error,
 	) {
 		result, err := s.handleGetStandards(ctx, request, input)
-		if err != nil {
-			return result, nil, err
-		}
-		// Extract text content from the result
-		var textResult string
-		if len(result.Content) > 0 {
-			if textContent, ok := result.Content[0].(*mcp.TextContent); ok {
-				textResult = textContent.Text
-			}
-		}
-		return result, map[string]string{"result": textResult}, nil
+		return s.toolResult(result, err, input, getStandardsInputSchema)
 	})
 
+	s.registerMetaTool()
+	s.registerTagsTool()
+	s.registerServerInfoTool()
+
+	// Register get_standards_by_path tool only when the standards source can address paths
+	if _, ok := s.standardLoader.(PathStandardLoader); ok {
+		s.registerPathTool()
+	}
+
+	// Register get_config tool only when explicitly enabled
+	if s.cfg.IsConfigToolEnabled() {
+		s.registerConfigTool()
+	}
+
 	return nil
 }
 
 // handleListStandards handles the list_standards tool request.
-func (s *MCP) handleListStandards(ctx context.Context, _ *mcp.CallToolRequest, input map[string]any) (
+func (s *MCP) handleListStandards(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
 	*mcp.CallToolResult,
 	error,
 ) {
-	s.auditLogger.LogClientRequest("mcp-client", "list_standards", input)
+	metadata := newRequestMetadata(request.Session)
+	s.auditLogger.LogClientRequest(metadata.ClientID, "list_standards", input)
+
+	logger := s.requestLogger(request)
+
+	sortMode, err := optionalEnum(input, "sort", sortByName, sortByPath, sortByOrder, sortByReviewBy)
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	limit, hasLimit, err := optionalNonNegativeInt(input, "limit")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+	if !hasLimit {
+		limit = s.cfg.GetDefaultListLimit()
+	}
+
+	namesOnly, err := optionalBool(input, "names_only")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	includeDisabled, err := optionalBool(input, "include_disabled")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	tags, err := optionalStringList(input, "tags")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	tagMatch, err := optionalEnum(input, "tag_match", tagMatchAny, tagMatchAll)
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	language, err := optionalString(input, "lang")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+	language = strings.ToLower(language)
 
-	domainResult, err := s.standardLoader.ListStandards(ctx)
+	requestContext, err := optionalStringMap(input, "context")
 	if err != nil {
-		s.auditLogger.LogClientResponse("mcp-client", nil, err)
-		return &mcp.CallToolResult{
-			IsError:           true,
-			Meta:              mcp.Meta{},
-			Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: err.Error()}},
-			StructuredContent: err.Error(),
-		}, err
+		return s.fail(metadata, err)
+	}
+
+	logger.Debug("Listing standards", "sort", sortMode, "limit", limit, "names_only", namesOnly,
+		"include_disabled", includeDisabled, "tags", tags, "tag_match", tagMatch, "lang", language,
+		"context", requestContext, "client", metadata.ClientID, "session_id", metadata.SessionID)
+
+	domainResult, err := s.standardLoader.ListStandards(s.requestProgress(s.loaderContext(ctx, logger), request))
+	if errors.Is(err, domain.ErrFolderDisappeared) {
+		// Degrade to an empty result, the folder may be mounted again
+		logger.Warn("Serving no standards", "error", err)
+		domainResult, err = nil, nil
 	}
+	if err != nil {
+		logger.Error("Failed to list standards", "error", err)
+		return s.fail(metadata, err)
+	}
+
+	logger.Debug("Listed standards", "count", len(domainResult))
 
-	formattedResult := formatStandardInfos(domainResult)
+	domainResult = s.filterAllowedInfos(domainResult)
+	domainResult = filterDisabledInfos(domainResult, includeDisabled)
+	domainResult = filterByTags(domainResult, tags, tagMatch)
+	domainResult = filterInfosByContext(domainResult, requestContext)
+	sortStandardInfos(domainResult, sortMode)
+	domainResult = groupLanguageVariants(domainResult, language, s.cfg.GetDefaultLanguage())
+	overviewFirst(domainResult)
+	localizeInfoDescriptions(domainResult, language, s.cfg.GetDefaultLanguage())
+
+	total := len(domainResult)
+	if limit > 0 && total > limit {
+		domainResult = domainResult[:limit]
+	}
+
+	now := time.Now()
+
+	var formattedResult string
+	if namesOnly {
+		formattedResult = formatStandardNames(domainResult)
+	} else {
+		formattedResult = formatStandardInfos(domainResult, now, s.cfg.GetDefaultDescription(), s.cfg.GetDisplayNames())
+	}
+	if total == 0 && (len(tags) > 0 || len(requestContext) > 0) {
+		// The filters of the request matched nothing, guide the client to broaden them
+		formattedResult = formatNoResults(s.noResultsPrompt())
+	}
+	if len(domainResult) < total {
+		formattedResult += "\n\n" + fmt.Sprintf(truncatedListNote, len(domainResult), total)
+	}
+	formattedResult = normalizeTrailingNewline(formattedResult, s.cfg.IsTrailingNewlineEnabled())
 
 	// Return formatted plain text result
-	s.auditLogger.LogClientResponse("mcp-client", formattedResult, nil)
+	s.auditLogger.LogClientResponse(metadata.ClientID, formattedResult, nil)
+	output := newToolOutput(formattedResult)
+	output.Warnings = staleWarnings(domainResult, now)
+	output.Standards = listedStandardsOf(domainResult)
+
 	return &mcp.CallToolResult{
 		IsError:           false,
 		Meta:              mcp.Meta{},
 		Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: formattedResult}},
-		StructuredContent: formattedResult,
+		StructuredContent: output,
 	}, nil
 }
 
 // handleGetStandards handles the get_standards tool request.
-func (s *MCP) handleGetStandards(ctx context.Context, _ *mcp.CallToolRequest, input map[string]any) (
+func (s *MCP) handleGetStandards(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
 	*mcp.CallToolResult,
 	error,
 ) {
-	s.auditLogger.LogClientRequest("mcp-client", "get_standards", input)
+	metadata := newRequestMetadata(request.Session)
+	s.auditLogger.LogClientRequest(metadata.ClientID, "get_standards", input)
+
+	logger := s.requestLogger(request)
 
 	// Extract standard names from input
 	standardNamesRaw, ok := input["standard_names"]
 	if !ok {
 		err := errors.New("standard_names parameter is required")
-		s.auditLogger.LogClientResponse("mcp-client", nil, err)
-		return &mcp.CallToolResult{
-			IsError:           true,
-			Meta:              mcp.Meta{},
-			Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: err.Error()}},
-			StructuredContent: err.Error(),
-		}, err
+		return s.fail(metadata, err)
 	}
 
 	// Convert standardNamesRaw to []string, handling both []string and []any cases
@@ -309,6 +684,9 @@ func (s *MCP) handleGetStandards(ctx context.Context, _ *mcp.CallToolRequest, in
 	var err error
 
 	switch standardNamesTyped := standardNamesRaw.(type) {
+	case string:
+		// Lenient case for clients that send a single name instead of an array
+		standardNames = []string{standardNamesTyped}
 	case []string:
 		// Direct case (usually from unit tests)
 		standardNames = standardNamesTyped
@@ -324,38 +702,157 @@ func (s *MCP) handleGetStandards(ctx context.Context, _ *mcp.CallToolRequest, in
 			standardNames[i] = standardName
 		}
 	default:
-		err = errors.New("standard_names must be an array of strings")
+		err = errors.New("standard_names must be a string or an array of strings")
+	}
+
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	// Cap the per-request cost before touching the standards source
+	if maxNames := s.cfg.GetMaxGetNames(); maxNames > 0 && len(standardNames) > maxNames {
+		err = fmt.Errorf("too many standard names: %d (maximum is %d per call)", len(standardNames), maxNames)
+		return s.fail(metadata, err)
+	}
+
+	language, err := optionalString(input, "lang")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+	language = strings.ToLower(language)
+
+	includeDisabled, err := optionalBool(input, "include_disabled")
+	if err != nil {
+		return s.fail(metadata, err)
 	}
 
+	raw, err := optionalBool(input, "raw")
 	if err != nil {
-		s.auditLogger.LogClientResponse("mcp-client", nil, err)
-		return &mcp.CallToolResult{
-			IsError:           true,
-			Meta:              mcp.Meta{},
-			Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: err.Error()}},
-			StructuredContent: err.Error(),
-		}, err
+		return s.fail(metadata, err)
 	}
 
-	domainResult, err := s.standardLoader.GetStandards(ctx, standardNames)
+	sortMode, err := optionalEnum(input, "sort", sortByPriority)
 	if err != nil {
-		s.auditLogger.LogClientResponse("mcp-client", nil, err)
-		return &mcp.CallToolResult{
-			IsError:           true,
-			Meta:              mcp.Meta{},
-			Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: err.Error()}},
-			StructuredContent: err.Error(),
-		}, err
+		return s.fail(metadata, err)
 	}
 
-	formattedResult := formatStandards(domainResult)
+	maxBytes, _, err := optionalNonNegativeInt(input, "max_bytes")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	includeTOC, err := optionalBool(input, "include_toc")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	format, err := optionalEnum(input, "format", formatDocument)
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	includeFrontmatter, err := optionalBool(input, "include_frontmatter")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	showSize, err := optionalBool(input, "show_size")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	requestContext, err := optionalStringMap(input, "context")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	logger.Debug("Getting standards", "standard_names", standardNames, "lang", language,
+		"include_disabled", includeDisabled, "raw", raw, "sort", sortMode, "max_bytes", maxBytes,
+		"include_toc", includeTOC, "format", format, "include_frontmatter", includeFrontmatter, "show_size", showSize,
+		"context", requestContext, "client", metadata.ClientID, "session_id", metadata.SessionID)
+
+	// Tell an empty request from one matching nothing when configured
+	if len(standardNames) == 0 {
+		if result, text, ok := s.emptyGetResult(); ok {
+			s.auditLogger.LogClientResponse(metadata.ClientID, text, nil)
+			return result, nil
+		}
+	}
+
+	loaderCtx := s.loaderContext(ctx, logger)
+	if raw {
+		loaderCtx = shared.WithRawContent(loaderCtx)
+	}
+	if includeFrontmatter {
+		loaderCtx = shared.WithFrontmatter(loaderCtx)
+	}
+
+	// Standards missing from the allowlist are reported as not found
+	domainResult, skipped, err := s.standardLoader.GetStandards(loaderCtx, s.filterAllowedNames(standardNames))
+	if errors.Is(err, domain.ErrFolderDisappeared) {
+		// Degrade to an empty result, the folder may be mounted again
+		logger.Warn("Serving no standards", "error", err)
+		domainResult, skipped, err = nil, nil, nil
+	}
+	if err != nil {
+		logger.Error("Failed to get standards", "error", err)
+		return s.fail(metadata, err)
+	}
+
+	domainResult = filterDisabledStandards(domainResult, includeDisabled)
+	domainResult = filterStandardsByContext(domainResult, requestContext)
+	domainResult = selectLanguageVariants(domainResult, language, s.cfg.GetDefaultLanguage())
+	localizeDescriptions(domainResult, language, s.cfg.GetDefaultLanguage())
+	domainResult = expandTemplateVars(domainResult, s.cfg.GetTemplateVars())
+	sortStandards(domainResult, sortMode)
+	warnings := notFoundWarnings(standardNames, domainResult, skipped)
+
+	logger.Debug("Retrieved standards", "requested", len(standardNames), "found", len(domainResult))
+
+	budget := s.responseBudget().lowered(maxBytes)
+	domainResult, omitted, omittedSize := budget.apply(domainResult)
+	s.metrics.addStandardsServed(len(domainResult))
+
+	wrapper := s.contentWrapper()
+	wrapper.showSize = showSize
+	var formattedResult string
+	switch {
+	case len(domainResult) == 0 && len(omitted) > 0:
+		// Every found standard exceeds the size limit, the omitted note is the whole result
+	case format == formatDocument:
+		formattedResult = formatStandardsDocument(domainResult, wrapper)
+	default:
+		formattedResult = formatStandards(domainResult, wrapper, includeTOC)
+	}
+	omittedNote := ""
+	if len(omitted) > 0 {
+		logger.Debug("Omitted standards exceeding the response size limit", "omitted", omitted)
+		omittedNote = budget.omittedNote(omitted, omittedSize)
+		if formattedResult != "" {
+			formattedResult += "\n\n"
+		}
+		formattedResult += omittedNote
+	}
+	formattedResult = normalizeTrailingNewline(formattedResult, s.cfg.IsTrailingNewlineEnabled())
+
+	content := []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: formattedResult}}
+	// A document is a single content block, annotating its parts would split it
+	if s.cfg.IsContentAnnotationsEnabled() && len(domainResult) > 0 && format != formatDocument {
+		content = annotatedStandardContents(domainResult, wrapper, includeTOC)
+		if omittedNote != "" {
+			content = append(content, &mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: omittedNote})
+		}
+	}
 
 	// Return formatted plain text result
-	s.auditLogger.LogClientResponse("mcp-client", formattedResult, nil)
+	s.auditLogger.LogClientResponse(metadata.ClientID, formattedResult, nil)
+	output := newToolOutput(formattedResult)
+	output.Warnings = warnings
+
 	return &mcp.CallToolResult{
 		IsError:           false,
 		Meta:              mcp.Meta{},
-		Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: formattedResult}},
-		StructuredContent: formattedResult,
+		Content:           content,
+		StructuredContent: output,
 	}, nil
 }
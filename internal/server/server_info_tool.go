@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// BuildInfo describes the build of the server binary, set at build time via ldflags.
+type BuildInfo struct {
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+	Date    string `json:"date"`
+	BuiltBy string `json:"built_by"`
+}
+
+// serverInfo is the server identity and usage returned by the server_info tool.
+type serverInfo struct {
+	Name string `json:"name"`
+	BuildInfo
+	// Metrics count the tool calls completed before the server_info call.
+	Metrics metricsSnapshot `json:"metrics"`
+}
+
+// registerServerInfoTool registers the server_info tool with the MCP server.
+func (s *MCP) registerServerInfoTool() {
+	serverInfoInputSchema := map[string]any{
+		"type":       "object",
+		"properties": map[string]any{},
+	}
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name: "server_info",
+		Description: "Returns the name and build information of the agent-standards-mcp server: " +
+			"version, commit, build date and builder, and usage counters: calls per tool, standards served, " +
+			"bytes delivered and errors by code. Use it to report which server version is running and how it is used.",
+		InputSchema:  serverInfoInputSchema,
+		OutputSchema: toolOutputSchema("Server build information as JSON"),
+		Meta:         mcp.Meta{},
+		Annotations:  s.readOnlyToolAnnotations("Server Info"),
+		Title:        "Server Info",
+	}, func(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
+		*mcp.CallToolResult, toolOutput, error,
+	) {
+		result, err := s.handleServerInfo(ctx, request, input)
+		return s.toolResult(result, err, input, serverInfoInputSchema)
+	})
+}
+
+// handleServerInfo handles the server_info tool request.
+func (s *MCP) handleServerInfo(_ context.Context, request *mcp.CallToolRequest, input map[string]any) (
+	*mcp.CallToolResult,
+	error,
+) {
+	metadata := newRequestMetadata(request.Session)
+	s.auditLogger.LogClientRequest(metadata.ClientID, "server_info", input)
+
+	logger := s.requestLogger(request)
+	logger.Debug("Getting server info", "client", metadata.ClientID, "session_id", metadata.SessionID)
+
+	data, err := json.MarshalIndent(serverInfo{
+		Name:      serverName,
+		BuildInfo: s.buildInfo,
+		Metrics:   s.metrics.snapshot(),
+	}, "", "  ")
+	if err != nil {
+		err = fmt.Errorf("failed to marshal server info: %w", err)
+		logger.Error("Failed to get server info", "error", err)
+		return s.fail(metadata, err)
+	}
+
+	formattedResult := string(data)
+
+	s.auditLogger.LogClientResponse(metadata.ClientID, formattedResult, nil)
+	return &mcp.CallToolResult{
+		IsError:           false,
+		Meta:              mcp.Meta{},
+		Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: formattedResult}},
+		StructuredContent: newToolOutput(formattedResult),
+	}, nil
+}
@@ -3,8 +3,15 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/n-r-w/agent-standards-mcp/internal/config"
@@ -26,7 +33,7 @@ func TestNewServer(t *testing.T) {
 	auditLogger := shared.NewMockAuditLogger(ctrl)
 	standardLoader := NewMockStandardLoader(ctrl)
 
-	server, err := New(cfg, logger, auditLogger, standardLoader)
+	server, err := New(cfg, logger, auditLogger, standardLoader, testBuildInfo())
 	require.NoError(t, err)
 	require.NotNil(t, server)
 
@@ -35,6 +42,7 @@ func TestNewServer(t *testing.T) {
 	assert.Equal(t, logger, server.logger)
 	assert.Equal(t, auditLogger, server.auditLogger)
 	assert.Equal(t, standardLoader, server.standardLoader)
+	assert.Equal(t, testBuildInfo(), server.buildInfo)
 	assert.NotNil(t, server.server)
 }
 
@@ -62,30 +70,230 @@ func TestServer_Stop(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestServer_CheckStandards(t *testing.T) {
+	tests := []struct {
+		name      string
+		required  bool
+		standards []domain.StandardInfo
+		expectErr bool
+	}{
+		{"Not required skips the scan", false, nil, false},
+		{"Required with standards", true, []domain.StandardInfo{createTestStandardInfo("standard1", "Description 1")}, false},
+		{"Required with empty folder", true, []domain.StandardInfo{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.RequireStandards = tt.required
+
+			ctx := context.Background()
+			if tt.required {
+				server.standardLoader.(*MockStandardLoader).EXPECT().
+					ListStandards(ctx).
+					Return(tt.standards, nil)
+			}
+			if tt.required && !tt.expectErr {
+				server.logger.(*shared.MockLogger).EXPECT().
+					Info("Standards available", "count", len(tt.standards))
+			}
+
+			err := server.CheckStandards(ctx)
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "no standards found")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestServer_SelfTest(t *testing.T) {
+	listed := createTestStandardInfo("standard1", "Description 1")
+
+	tests := []struct {
+		name        string
+		standards   []domain.StandardInfo
+		loaded      []domain.Standard
+		getErr      error
+		expectFail  string
+		checksCount int
+	}{
+		{
+			name:        "healthy",
+			standards:   []domain.StandardInfo{listed},
+			loaded:      []domain.Standard{createTestStandard("standard1", "Description 1", "Content 1")},
+			checksCount: 3,
+		},
+		{
+			name:        "empty folder",
+			standards:   []domain.StandardInfo{},
+			expectFail:  "FAIL list standards: no standards found",
+			checksCount: 1,
+		},
+		{
+			name:        "unreadable standard",
+			standards:   []domain.StandardInfo{listed},
+			getErr:      errors.New("permission denied"),
+			expectFail:  "FAIL get standard standard1: failed to get standard: permission denied",
+			checksCount: 2,
+		},
+		{
+			name:        "description mismatch",
+			standards:   []domain.StandardInfo{listed},
+			loaded:      []domain.Standard{createTestStandard("standard1", "Other", "Content 1")},
+			expectFail:  "FAIL round trip standard1: description",
+			checksCount: 3,
+		},
+		{
+			name:        "standard missing",
+			standards:   []domain.StandardInfo{listed},
+			loaded:      []domain.Standard{},
+			expectFail:  "FAIL round trip standard1: listed standard was not returned",
+			checksCount: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(ctx).
+				Return(tt.standards, nil)
+			if len(tt.standards) > 0 {
+				server.standardLoader.(*MockStandardLoader).EXPECT().
+					GetStandards(ctx, []string{"standard1"}).
+					Return(tt.loaded, nil, tt.getErr)
+			}
+
+			report := server.SelfTest(ctx)
+			require.Len(t, report.Checks, tt.checksCount)
+			if tt.expectFail == "" {
+				assert.True(t, report.Passed())
+				assert.Equal(t,
+					"PASS list standards\nPASS get standard standard1\nPASS round trip standard1\nSelf-test passed",
+					report.String())
+				return
+			}
+			assert.False(t, report.Passed())
+			assert.Contains(t, report.String(), tt.expectFail)
+			assert.True(t, strings.HasSuffix(report.String(), "Self-test failed"))
+		})
+	}
+}
+
+func TestServer_LogStartupDiagnostics(t *testing.T) {
+	tests := []struct {
+		name               string
+		standards          []domain.StandardInfo
+		listErr            error
+		expectedDiscovered int
+	}{
+		{
+			name: "standards discovered",
+			standards: []domain.StandardInfo{
+				createTestStandardInfo("standard1", "Description 1"),
+				createTestStandardInfo("standard2", "Description 2"),
+			},
+			listErr:            nil,
+			expectedDiscovered: 2,
+		},
+		{
+			name:               "discovery failed",
+			standards:          nil,
+			listErr:            errors.New("folder unreadable"),
+			expectedDiscovered: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.ConfigTool = true
+
+			ctx := context.Background()
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(ctx).
+				Return(tt.standards, tt.listErr)
+
+			logger := &fieldsLogger{messages: nil, fields: nil}
+			server.logger = logger
+
+			server.LogStartupDiagnostics(ctx)
+
+			require.Equal(t, []string{"Starting agent-standards-mcp server"}, logger.messages)
+			fields := logger.fields
+			assert.Equal(t, tt.expectedDiscovered, fields["standards_discovered"])
+			assert.Equal(t, tt.listErr, fields["discovery_error"])
+			assert.Equal(t, "1.2.3", fields["version"])
+			assert.Equal(t, "abc1234", fields["commit"])
+			assert.Equal(t, "/tmp", fields["standards_location"])
+			assert.Equal(t, []string{"list_standards", "get_standards", "get_standard_meta", "list_tags", "server_info", "get_config"},
+				fields["tools"])
+			assert.Equal(t, "stdio", fields["transport"])
+			assert.Equal(t, 100, fields["max_standards"])
+			assert.Equal(t, 10240, fields["max_standard_size"])
+		})
+	}
+}
+
 // Test helper functions
 
+// fieldsLogger records the messages and the key-value pairs of the last record logged at any level.
+type fieldsLogger struct {
+	messages []string
+	fields   map[string]any
+}
+
+func (l *fieldsLogger) record(msg string, args ...any) {
+	l.messages = append(l.messages, msg)
+	l.fields = make(map[string]any, len(args)/2)
+	for i := 0; i+1 < len(args); i += 2 {
+		l.fields[args[i].(string)] = args[i+1]
+	}
+}
+
+func (l *fieldsLogger) Debug(msg string, args ...any) { l.record(msg, args...) }
+func (l *fieldsLogger) Info(msg string, args ...any)  { l.record(msg, args...) }
+func (l *fieldsLogger) Warn(msg string, args ...any)  { l.record(msg, args...) }
+func (l *fieldsLogger) Error(msg string, args ...any) { l.record(msg, args...) }
+
 func createTestConfig() *config.Config {
 	return &config.Config{
 		LogLevel:        "ERROR",
 		Folder:          "/tmp",
 		MaxStandards:    100,
 		MaxStandardSize: 10240,
+		Strict:          true,
 	}
 }
 
 func createTestServer(t *testing.T) (*MCP, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
 	logger := shared.NewMockLogger(ctrl)
+	// Handlers emit diagnostic logs that are not the subject of these tests
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
 	auditLogger := shared.NewMockAuditLogger(ctrl)
 	standardLoader := NewMockStandardLoader(ctrl)
 
-	server, err := New(createTestConfig(), logger, auditLogger, standardLoader)
+	server, err := New(createTestConfig(), logger, auditLogger, standardLoader, testBuildInfo())
 	require.NoError(t, err)
 	require.NotNil(t, server)
 
 	return server, ctrl
 }
 
+func testBuildInfo() BuildInfo {
+	return BuildInfo{Version: "1.2.3", Commit: "abc1234", Date: "2026-01-31", BuiltBy: "test"}
+}
+
 func createTestStandardInfo(name, description string) domain.StandardInfo {
 	return domain.StandardInfo{
 		Name:        name,
@@ -145,6 +353,14 @@ func TestMCP_handleListStandards_Success(t *testing.T) {
 	require.True(t, ok)
 	expectedText := prompt.LoadRelevantStandardsPrompt() + "\ntest-standard-1: Test standard 1\ntest-standard-2: Test standard 2"
 	assert.Equal(t, expectedText, textContent.Text)
+
+	// The structured output lists the standards themselves, not only the formatted text
+	output := outputOf(result)
+	assert.Equal(t, expectedText, output.Result)
+	assert.Equal(t, []listedStandard{
+		{Name: "test-standard-1", Description: "Test standard 1"},
+		{Name: "test-standard-2", Description: "Test standard 2"},
+	}, output.Standards)
 }
 
 func TestMCP_handleListStandards_EmptyResult(t *testing.T) {
@@ -185,6 +401,11 @@ func TestMCP_handleListStandards_EmptyResult(t *testing.T) {
 	textContent, ok := result.Content[0].(*mcp.TextContent)
 	require.True(t, ok)
 	assert.Equal(t, "No standards found.", textContent.Text)
+
+	// An empty listing is still present in the structured output
+	data, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"result": "No standards found.", "standards": []}`, string(data))
 }
 
 func TestMCP_handleListStandards_StandardLoaderError(t *testing.T) {
@@ -246,7 +467,7 @@ func TestMCP_handleGetStandards_Success(t *testing.T) {
 	// Set up mock expectations
 	server.standardLoader.(*MockStandardLoader).EXPECT().
 		GetStandards(ctx, []string{"test-standard-1", "test-standard-2"}).
-		Return(expectedStandards, nil)
+		Return(expectedStandards, nil, nil)
 
 	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
 		LogClientRequest("mcp-client", "get_standards", input)
@@ -289,7 +510,7 @@ func TestMCP_handleGetStandards_EmptyResult(t *testing.T) {
 	// Set up mock expectations
 	server.standardLoader.(*MockStandardLoader).EXPECT().
 		GetStandards(ctx, []string{"nonexistent-standard"}).
-		Return(expectedStandards, nil)
+		Return(expectedStandards, nil, nil)
 
 	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
 		LogClientRequest("mcp-client", "get_standards", input)
@@ -356,10 +577,10 @@ func TestMCP_handleGetStandards_StandardNamesNotArray(t *testing.T) {
 		Extra:   nil,
 	}
 	input := map[string]any{
-		"standard_names": "not-an-array", // Should be array
+		"standard_names": 42, // Should be a string or an array
 	}
 
-	expectedError := errors.New("standard_names must be an array of strings")
+	expectedError := errors.New("standard_names must be a string or an array of strings")
 
 	// Set up mock expectations
 	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
@@ -432,7 +653,7 @@ func TestMCP_handleGetStandards_StandardLoaderError(t *testing.T) {
 	// Set up mock expectations
 	server.standardLoader.(*MockStandardLoader).EXPECT().
 		GetStandards(ctx, []string{"test-standard"}).
-		Return(nil, expectedError)
+		Return(nil, nil, expectedError)
 
 	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
 		LogClientRequest("mcp-client", "get_standards", input)
@@ -519,7 +740,7 @@ func TestMCP_handleGetStandards_LargeContent(t *testing.T) {
 	// Set up mock expectations
 	server.standardLoader.(*MockStandardLoader).EXPECT().
 		GetStandards(ctx, []string{"large-standard"}).
-		Return(expectedStandards, nil)
+		Return(expectedStandards, nil, nil)
 
 	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
 		LogClientRequest("mcp-client", "get_standards", input)
@@ -555,3 +776,2539 @@ func TestServer_RegisterTools(t *testing.T) {
 	err := server.RegisterTools()
 	require.NoError(t, err)
 }
+
+func TestMCP_handleListStandards_Sort(t *testing.T) {
+	tests := []struct {
+		name     string
+		sort     string
+		expected []string
+	}{
+		{
+			name:     "loader order",
+			sort:     "",
+			expected: []string{"go/testing/unit", "zeta", "go/errors", "alpha"},
+		},
+		{
+			name:     "by name",
+			sort:     "name",
+			expected: []string{"alpha", "go/errors", "go/testing/unit", "zeta"},
+		},
+		{
+			name:     "by path",
+			sort:     "path",
+			expected: []string{"alpha", "zeta", "go/errors", "go/testing/unit"},
+		},
+		{
+			name:     "by order",
+			sort:     "order",
+			expected: []string{"go/errors", "go/testing/unit", "zeta", "alpha"},
+		},
+		{
+			name:     "by review date",
+			sort:     "review_by",
+			expected: []string{"alpha", "go/errors", "zeta", "go/testing/unit"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			input := map[string]any{}
+			if tt.sort != "" {
+				input["sort"] = tt.sort
+			}
+
+			first, second := 1, 2
+			soon := time.Now().AddDate(0, 1, 0)
+			later := soon.AddDate(0, 1, 0)
+			loaded := []domain.StandardInfo{
+				{Name: "go/testing/unit", Description: "Unit", Path: "go/testing/unit.md", Order: &second},
+				{Name: "zeta", Description: "Zeta", Path: "zeta.md", Order: &second, ReviewBy: later},
+				{Name: "go/errors", Description: "Errors", Path: "go/errors.md", Order: &first, ReviewBy: later},
+				{Name: "alpha", Description: "Alpha", Path: "alpha.md", ReviewBy: soon},
+			}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(ctx).
+				Return(loaded, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "list_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			lines := strings.Split(strings.TrimPrefix(textContent.Text, prompt.LoadRelevantStandardsPrompt()+"\n"), "\n")
+			names := make([]string, 0, len(lines))
+			for _, line := range lines {
+				names = append(names, strings.SplitN(line, ":", 2)[0])
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestMCP_handleListStandards_InvalidSort(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{"sort": "size"}
+
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "list_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", nil, gomock.Any())
+
+	result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid sort")
+	require.True(t, result.IsError)
+}
+
+func TestMCP_handleGetStandards_SortByPriority(t *testing.T) {
+	withPriority := func(name string, priority float64) domain.Standard {
+		standard := createTestStandard(name, "Description", "Content")
+		standard.Priority = priority
+		return standard
+	}
+
+	tests := []struct {
+		name     string
+		sort     string
+		expected []string
+	}{
+		{
+			name:     "requested order",
+			sort:     "",
+			expected: []string{"style", "security", "architecture", "errors"},
+		},
+		{
+			name:     "by priority",
+			sort:     "priority",
+			expected: []string{"architecture", "security", "errors", "style"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			names := []string{"style", "security", "architecture", "errors"}
+			input := map[string]any{"standard_names": names}
+			if tt.sort != "" {
+				input["sort"] = tt.sort
+			}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(ctx, names).
+				Return([]domain.Standard{
+					withPriority("style", 0),
+					withPriority("security", 0.9),
+					withPriority("architecture", 0.9),
+					withPriority("errors", 0.5),
+				}, nil, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+
+			positions := make([]int, 0, len(tt.expected))
+			for _, name := range tt.expected {
+				position := strings.Index(textContent.Text, "## "+name+":")
+				require.NotEqual(t, -1, position, name)
+				positions = append(positions, position)
+			}
+			assert.True(t, slices.IsSorted(positions), "standards out of order: %s", textContent.Text)
+		})
+	}
+}
+
+func TestMCP_handleGetStandards_Raw(t *testing.T) {
+	for _, raw := range []bool{false, true} {
+		t.Run(fmt.Sprintf("raw=%t", raw), func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+
+			input := map[string]any{"standard_names": []string{"standard1"}, "raw": raw}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(gomock.Any(), []string{"standard1"}).
+				DoAndReturn(func(ctx context.Context, _ []string) ([]domain.Standard, []domain.SkippedStandard, error) {
+					assert.Equal(t, raw, shared.IsRawContent(ctx))
+					return []domain.Standard{createTestStandard("standard1", "Description 1", "Line\r\nbreak")}, nil, nil
+				})
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleGetStandards(context.Background(), &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+		})
+	}
+}
+
+func TestMCP_handleGetStandards_InvalidSort(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{"standard_names": []string{"standard1"}, "sort": "name"}
+
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", nil, gomock.Any())
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid sort")
+	require.True(t, result.IsError)
+}
+
+func TestMCP_handleGetStandards_Language(t *testing.T) {
+	loaded := []domain.Standard{
+		{Name: "errors", Description: "Errors", Content: "untagged errors", Language: ""},
+		{Name: "errors", Description: "Errors", Content: "english errors", Language: "en"},
+		{Name: "errors", Description: "Errors", Content: "russian errors", Language: "ru"},
+		{Name: "testing", Description: "Testing", Content: "untagged testing", Language: ""},
+		{Name: "testing", Description: "Testing", Content: "german testing", Language: "de"},
+	}
+
+	tests := []struct {
+		name     string
+		lang     string
+		expected []string
+	}{
+		{
+			name:     "requested language",
+			lang:     "ru",
+			expected: []string{"russian errors", "untagged testing"},
+		},
+		{
+			name:     "default language when not requested",
+			lang:     "",
+			expected: []string{"english errors", "untagged testing"},
+		},
+		{
+			name:     "uppercase requested language",
+			lang:     "DE",
+			expected: []string{"english errors", "german testing"},
+		},
+		{
+			name:     "fallback for unknown language",
+			lang:     "fr",
+			expected: []string{"english errors", "untagged testing"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.DefaultLanguage = "en"
+
+			ctx := context.Background()
+			input := map[string]any{"standard_names": []string{"errors", "testing"}}
+			if tt.lang != "" {
+				input["lang"] = tt.lang
+			}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(ctx, []string{"errors", "testing"}).
+				Return(loaded, nil, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			for _, content := range tt.expected {
+				assert.Contains(t, textContent.Text, content)
+			}
+			assert.Equal(t, len(tt.expected), strings.Count(textContent.Text, "```md"))
+		})
+	}
+}
+
+func TestMCP_handleListStandards_LanguageVariants(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+	server.cfg.DefaultLanguage = "en"
+
+	ctx := context.Background()
+	input := map[string]any{}
+
+	loaded := []domain.StandardInfo{
+		{Name: "errors", Description: "Russian errors", Path: "errors.ru.md", Language: "ru"},
+		{Name: "errors", Description: "English errors", Path: "errors.en.md", Language: "en"},
+		{Name: "testing", Description: "Testing", Path: "testing.md"},
+	}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		ListStandards(ctx).
+		Return(loaded, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "list_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t,
+		prompt.LoadRelevantStandardsPrompt()+"\nerrors [en, ru]: English errors\ntesting: Testing",
+		textContent.Text)
+}
+
+func TestMCP_handleListStandards_LocalizedDescriptions(t *testing.T) {
+	loaded := []domain.StandardInfo{
+		{
+			Name:         "errors",
+			Description:  "Error handling",
+			Path:         "errors.md",
+			Descriptions: map[string]string{"en": "Error handling", "ru": "Обработка ошибок"},
+		},
+		{Name: "testing", Description: "Testing", Path: "testing.md"},
+	}
+
+	tests := []struct {
+		name     string
+		lang     string
+		expected string
+	}{
+		{name: "requested language", lang: "RU", expected: "errors: Обработка ошибок\ntesting: Testing"},
+		{name: "default language", lang: "", expected: "errors: Error handling\ntesting: Testing"},
+		{name: "missing language", lang: "de", expected: "errors: Error handling\ntesting: Testing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.DefaultLanguage = "en"
+
+			ctx := context.Background()
+			input := map[string]any{}
+			if tt.lang != "" {
+				input["lang"] = tt.lang
+			}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(ctx).
+				Return(slices.Clone(loaded), nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "list_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			assert.Equal(t, prompt.LoadRelevantStandardsPrompt()+"\n"+tt.expected, textContent.Text)
+		})
+	}
+}
+
+func TestMCP_handleListStandards_Overview(t *testing.T) {
+	tests := []struct {
+		name     string
+		loaded   []domain.StandardInfo
+		expected string
+	}{
+		{
+			name: "overview present",
+			loaded: []domain.StandardInfo{
+				{Name: "errors", Description: "Error handling", Path: "errors.md"},
+				{Name: "README", Description: "How the standards are organized", Path: "README.md"},
+				{Name: "go/README", Description: "Go standards", Path: "go/README.md"},
+			},
+			expected: "README [OVERVIEW]: How the standards are organized\nerrors: Error handling\n" +
+				"go/README: Go standards",
+		},
+		{
+			name: "underscore overview",
+			loaded: []domain.StandardInfo{
+				{Name: "errors", Description: "Error handling", Path: "errors.md"},
+				{Name: "_overview", Description: "Start here", Path: "_overview.md"},
+			},
+			expected: "_overview [OVERVIEW]: Start here\nerrors: Error handling",
+		},
+		{
+			name: "overview absent",
+			loaded: []domain.StandardInfo{
+				{Name: "errors", Description: "Error handling", Path: "errors.md"},
+				{Name: "testing", Description: "Testing", Path: "testing.md"},
+			},
+			expected: "errors: Error handling\ntesting: Testing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			input := map[string]any{}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(ctx).
+				Return(tt.loaded, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "list_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+			assert.Equal(t, prompt.LoadRelevantStandardsPrompt()+"\n"+tt.expected, outputOf(result).Result)
+		})
+	}
+}
+
+func TestIsOverview(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"README.md", true},
+		{"readme.md", true},
+		{"README.ru.md", true},
+		{"_overview.md", true},
+		{"_Overview.en.md", true},
+		{"go/README.md", false},
+		{"README.draft.md", false},
+		{"README.js.md", false},
+		{"overview.md", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isOverview(domain.StandardInfo{Name: "any", Path: tt.path}))
+		})
+	}
+}
+
+func TestMCP_handleGetStandardMeta(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{"name": "security"}
+
+	standard := createTestStandard("security", "Security rules", "Never log secrets")
+	standard.Title = "Security Rules"
+	standard.Tags = []string{"security", "go"}
+	standard.Priority = 0.9
+	standard.ReviewBy = time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandard(ctx, "security").
+		Return(standard, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standard_meta", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandardMeta(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.NotContains(t, textContent.Text, "Never log secrets")
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+	assert.Equal(t, map[string]any{
+		"name":        "security",
+		"description": "Security rules",
+		"title":       "Security Rules",
+		"tags":        []any{"security", "go"},
+		"priority":    0.9,
+		"review_by":   "2026-01-31",
+		"enabled":     true,
+	}, got)
+
+	output, ok := result.StructuredContent.(toolOutput)
+	require.True(t, ok)
+	assert.Equal(t, textContent.Text, output.Result)
+}
+
+func TestMCP_handleGetStandardMeta_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     map[string]any
+		loaderErr error
+		errMsg    string
+	}{
+		{
+			name:      "missing name",
+			input:     map[string]any{},
+			loaderErr: nil,
+			errMsg:    "name is required",
+		},
+		{
+			name:      "unknown standard",
+			input:     map[string]any{"name": "missing"},
+			loaderErr: fmt.Errorf("%w: missing", domain.ErrStandardNotFound),
+			errMsg:    "standard not found: missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+
+			if tt.loaderErr != nil {
+				server.standardLoader.(*MockStandardLoader).EXPECT().
+					GetStandard(ctx, "missing").
+					Return(domain.Standard{}, tt.loaderErr)
+			}
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standard_meta", tt.input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", nil, gomock.Any())
+
+			result, err := server.handleGetStandardMeta(ctx, &mcp.CallToolRequest{}, tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestMCP_handleGetStandardMeta_NotFound(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{"name": "missing"}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandard(gomock.Any(), "missing").
+		Return(domain.Standard{}, fmt.Errorf("%w: missing", domain.ErrStandardNotFound))
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standard_meta", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", nil, gomock.Any())
+
+	result, err := server.handleGetStandardMeta(ctx, &mcp.CallToolRequest{}, input)
+	require.ErrorIs(t, err, domain.ErrStandardNotFound)
+
+	// The typed error survives the conversion into the typed tool result
+	result, output, err := server.toolResult(result, err, input, map[string]any{})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Equal(t, &toolError{Code: "NOT_FOUND", Name: "missing"}, output.Error)
+	assert.Equal(t, "standard not found: missing", output.Result)
+	assert.Nil(t, output.Input)
+}
+
+// pathStandardLoader is a standard loader mock that also supports paths.
+type pathStandardLoader struct {
+	*MockStandardLoader
+	*MockPathStandardLoader
+}
+
+func TestMCP_handleGetStandardsByPath(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	pathLoader := NewMockPathStandardLoader(ctrl)
+	server.standardLoader = pathStandardLoader{
+		MockStandardLoader:     server.standardLoader.(*MockStandardLoader),
+		MockPathStandardLoader: pathLoader,
+	}
+
+	ctx := context.Background()
+	input := map[string]any{"paths": []any{"go/testing.md", "go/missing.md"}}
+
+	pathLoader.EXPECT().
+		GetStandardByPath(gomock.Any(), "go/testing.md").
+		Return(createTestStandard("go/testing", "Go testing", "Use table tests"), nil)
+	pathLoader.EXPECT().
+		GetStandardByPath(gomock.Any(), "go/missing.md").
+		Return(domain.Standard{}, fmt.Errorf("%w: go/missing.md", domain.ErrStandardNotFound))
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards_by_path", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandardsByPath(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	output := outputOf(result)
+	assert.Contains(t, output.Result, "Use table tests")
+	assert.Equal(t, []string{"standard at path go/missing.md not found"}, output.Warnings)
+}
+
+func TestMCP_handleGetStandardsByPath_Traversal(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	pathLoader := NewMockPathStandardLoader(ctrl)
+	server.standardLoader = pathStandardLoader{
+		MockStandardLoader:     server.standardLoader.(*MockStandardLoader),
+		MockPathStandardLoader: pathLoader,
+	}
+
+	ctx := context.Background()
+	input := map[string]any{"paths": []any{"../secret.md"}}
+
+	pathLoader.EXPECT().
+		GetStandardByPath(gomock.Any(), "../secret.md").
+		Return(domain.Standard{}, fmt.Errorf("%w: ../secret.md", domain.ErrPathTraversal))
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards_by_path", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", nil, gomock.Any())
+
+	result, err := server.handleGetStandardsByPath(ctx, &mcp.CallToolRequest{}, input)
+	require.ErrorIs(t, err, domain.ErrPathTraversal)
+	assert.True(t, result.IsError)
+}
+
+func TestMCP_handleGetConfig(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+	server.cfg.Recursive = true
+	server.cfg.ClientLogs = "warn"
+	server.cfg.NameStrategy = "first-dot"
+	server.cfg.DefaultLanguage = "ru"
+	server.cfg.ListLimit = 25
+	server.cfg.Annotations = true
+	server.cfg.Strict = false
+	server.cfg.LanguageVariants = true
+	server.cfg.CacheTTL = 5 * time.Minute
+
+	ctx := context.Background()
+	input := map[string]any{}
+
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_config", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetConfig(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+
+	var got configView
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+	assert.Equal(t, configView{
+		Folder:           server.cfg.Folder,
+		LogLevel:         server.cfg.LogLevel,
+		MaxStandards:     server.cfg.MaxStandards,
+		MaxStandardSize:  server.cfg.GetMaxStandardSize(),
+		Recursive:        server.cfg.Recursive,
+		MaxDepth:         server.cfg.MaxDepth,
+		ClientLogs:       "WARN",
+		NameStrategy:     server.cfg.NameStrategy,
+		LanguageVariants: true,
+		DefaultLanguage:  server.cfg.DefaultLanguage,
+		ListLimit:        server.cfg.ListLimit,
+		MaxGetNames:      server.cfg.MaxGetNames,
+		Annotations:      server.cfg.Annotations,
+		TemplateVars:     nil,
+		Allowlist:        nil,
+		Source:           "file",
+		SourceURL:        "",
+		SourcePath:       "",
+		SingleFile:       "",
+		Strict:           false,
+		MaxResponseSize:  server.cfg.GetMaxResponseSize(),
+		BudgetUnit:       string(server.cfg.GetBudgetUnit()),
+		CharsPerToken:    server.cfg.GetCharsPerToken(),
+		TruncateContent:  false,
+		MaxInputBytes:    server.cfg.GetMaxInputBytes(),
+		EmptyGet:         string(server.cfg.GetEmptyGet()),
+		CacheTTL:         "5m0s",
+		RequestDeadline:  server.cfg.GetRequestDeadline().String(),
+	}, got)
+}
+
+func TestMCP_handleServerInfo(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{}
+
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "server_info", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleServerInfo(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var got serverInfo
+	require.NoError(t, json.Unmarshal([]byte(outputOf(result).Result), &got))
+	assert.Equal(t, serverInfo{
+		Name:      "agent-standards-mcp",
+		BuildInfo: testBuildInfo(),
+		Metrics:   metricsSnapshot{ToolCalls: map[string]int64{}, Errors: map[string]int64{}},
+	}, got)
+}
+
+func TestFormatStandardInfo_StaleFlag(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		info     domain.StandardInfo
+		expected string
+	}{
+		{
+			name:     "no review date",
+			info:     domain.StandardInfo{Name: "errors", Description: "Errors"},
+			expected: "errors: Errors",
+		},
+		{
+			name:     "review date in the future",
+			info:     domain.StandardInfo{Name: "errors", Description: "Errors", ReviewBy: now.AddDate(0, 0, 1)},
+			expected: "errors: Errors",
+		},
+		{
+			name:     "review date passed",
+			info:     domain.StandardInfo{Name: "errors", Description: "Errors", ReviewBy: now.AddDate(0, 0, -1)},
+			expected: "errors [STALE]: Errors",
+		},
+		{
+			name: "review date passed with languages",
+			info: domain.StandardInfo{
+				Name: "errors", Description: "Errors", Languages: []string{"en", "ru"}, ReviewBy: now.AddDate(0, -1, 0),
+			},
+			expected: "errors [en, ru] [STALE]: Errors",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatStandardInfo(tt.info, now, "", config.DisplayNamesCanonical))
+		})
+	}
+}
+
+func TestFormatStandardInfo_DefaultDescription(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "errors: (no description)",
+		formatStandardInfo(domain.StandardInfo{Name: "errors"}, now, "(no description)", config.DisplayNamesCanonical))
+	assert.Equal(t, "errors: Errors",
+		formatStandardInfo(domain.StandardInfo{Name: "errors", Description: "Errors"}, now, "(no description)", config.DisplayNamesCanonical))
+	assert.Equal(t, "errors: ", formatStandardInfo(domain.StandardInfo{Name: "errors"}, now, "", config.DisplayNamesCanonical))
+}
+
+// connectTestSession connects a client with the given name to the server and returns the server side session.
+func connectTestSession(t *testing.T, server *MCP, clientName, clientVersion string) *mcp.ServerSession {
+	t.Helper()
+
+	ctx := context.Background()
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := server.server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: clientName, Version: clientVersion, Title: clientName}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = clientSession.Close()
+		_ = serverSession.Close()
+	})
+
+	return serverSession
+}
+
+func TestMCP_EchoInputOnError(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		t.Run(fmt.Sprintf("enabled=%t", enabled), func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.EchoInput = enabled
+
+			server.logger.(*shared.MockLogger).EXPECT().Info("Registering MCP tools")
+			require.NoError(t, server.RegisterTools())
+
+			ctx := context.Background()
+			clientTransport, serverTransport := mcp.NewInMemoryTransports()
+			serverSession, err := server.server.Connect(ctx, serverTransport, nil)
+			require.NoError(t, err)
+			client := mcp.NewClient(&mcp.Implementation{Name: "test-agent", Version: "1.0.0", Title: ""}, nil)
+			clientSession, err := client.Connect(ctx, clientTransport, nil)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				_ = clientSession.Close()
+				_ = serverSession.Close()
+			})
+
+			input := map[string]any{"limit": float64(5), "token": "secret"}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(gomock.Any()).
+				Return(nil, errors.New("folder unreadable"))
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("test-agent", "list_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("test-agent", nil, gomock.Any())
+
+			result, err := clientSession.CallTool(ctx, &mcp.CallToolParams{
+				Meta:      mcp.Meta{},
+				Name:      "list_standards",
+				Arguments: input,
+			})
+			require.NoError(t, err)
+			require.True(t, result.IsError)
+
+			if !enabled {
+				assert.Nil(t, result.StructuredContent)
+				return
+			}
+
+			data, err := json.Marshal(result.StructuredContent)
+			require.NoError(t, err)
+			var output toolOutput
+			require.NoError(t, json.Unmarshal(data, &output))
+			assert.Contains(t, output.Result, "folder unreadable")
+			assert.Equal(t, map[string]any{"limit": float64(5), "token": "[REDACTED]"}, output.Input)
+		})
+	}
+}
+
+func TestMCP_handleListStandards_PopulatedRequest(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	session := connectTestSession(t, server, "test-agent", "2.0.0")
+	request := &mcp.CallToolRequest{
+		Session: session,
+		Params:  &mcp.CallToolParamsRaw{Meta: mcp.Meta{}, Name: "list_standards", Arguments: nil},
+		Extra:   nil,
+	}
+
+	ctx := context.Background()
+	input := map[string]any{}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		ListStandards(ctx).
+		Return([]domain.StandardInfo{createTestStandardInfo("standard1", "Description 1")}, nil)
+	// Audit logs identify the client by the name it reported during initialization
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("test-agent", "list_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("test-agent", gomock.Any(), nil)
+
+	result, err := server.handleListStandards(ctx, request, input)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+}
+
+func TestNewRequestMetadata(t *testing.T) {
+	t.Run("without session", func(t *testing.T) {
+		assert.Equal(t, requestMetadata{ClientID: "mcp-client", ClientVersion: "", SessionID: ""}, newRequestMetadata(nil))
+	})
+
+	t.Run("with session", func(t *testing.T) {
+		server, ctrl := createTestServer(t)
+		defer ctrl.Finish()
+
+		session := connectTestSession(t, server, "test-agent", "2.0.0")
+
+		metadata := newRequestMetadata(session)
+		assert.Equal(t, "test-agent", metadata.ClientID)
+		assert.Equal(t, "2.0.0", metadata.ClientVersion)
+		assert.Equal(t, session.ID(), metadata.SessionID)
+	})
+}
+
+func TestMCP_handleListStandards_Limit(t *testing.T) {
+	loaded := []domain.StandardInfo{
+		createTestStandardInfo("alpha", "Alpha"),
+		createTestStandardInfo("beta", "Beta"),
+		createTestStandardInfo("gamma", "Gamma"),
+	}
+
+	tests := []struct {
+		name         string
+		defaultLimit int
+		input        map[string]any
+		expected     []string
+		expectedNote string
+	}{
+		{
+			name:         "default unlimited",
+			defaultLimit: 0,
+			input:        map[string]any{},
+			expected:     []string{"alpha", "beta", "gamma"},
+		},
+		{
+			name:         "default applied",
+			defaultLimit: 2,
+			input:        map[string]any{},
+			expected:     []string{"alpha", "beta"},
+			expectedNote: "Showing 2 of 3 standards.",
+		},
+		{
+			name:         "default larger than result",
+			defaultLimit: 5,
+			input:        map[string]any{},
+			expected:     []string{"alpha", "beta", "gamma"},
+		},
+		{
+			name:         "explicit limit overrides default",
+			defaultLimit: 2,
+			input:        map[string]any{"limit": float64(1)},
+			expected:     []string{"alpha"},
+			expectedNote: "Showing 1 of 3 standards.",
+		},
+		{
+			name:         "explicit zero limit is unlimited",
+			defaultLimit: 2,
+			input:        map[string]any{"limit": float64(0)},
+			expected:     []string{"alpha", "beta", "gamma"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.ListLimit = tt.defaultLimit
+
+			ctx := context.Background()
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(ctx).
+				Return(slices.Clone(loaded), nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "list_standards", tt.input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, tt.input)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			for _, info := range loaded {
+				if slices.Contains(tt.expected, info.Name) {
+					assert.Contains(t, textContent.Text, info.Name+": ")
+				} else {
+					assert.NotContains(t, textContent.Text, info.Name+": ")
+				}
+			}
+			if tt.expectedNote != "" {
+				assert.Contains(t, textContent.Text, tt.expectedNote)
+			} else {
+				assert.NotContains(t, textContent.Text, "Showing")
+			}
+		})
+	}
+}
+
+func TestMCP_handleListStandards_InvalidLimit(t *testing.T) {
+	for _, limit := range []any{float64(-1), float64(1.5), "10"} {
+		server, ctrl := createTestServer(t)
+
+		ctx := context.Background()
+		input := map[string]any{"limit": limit}
+
+		server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+			LogClientRequest("mcp-client", "list_standards", input)
+		server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+			LogClientResponse("mcp-client", nil, gomock.Any())
+
+		result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, input)
+		require.Error(t, err, "limit %v should be rejected", limit)
+		assert.True(t, result.IsError)
+		assert.Contains(t, err.Error(), "limit")
+
+		ctrl.Finish()
+	}
+}
+
+func TestMCP_handleListStandards_NamesOnly(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{"names_only": true}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		ListStandards(ctx).
+		Return([]domain.StandardInfo{
+			createTestStandardInfo("standard1", "Description 1"),
+			createTestStandardInfo("go/errors", "Description 2"),
+		}, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "list_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, "standard1\ngo/errors", textContent.Text)
+	assert.NotContains(t, textContent.Text, ":")
+	assert.NotContains(t, textContent.Text, "Description")
+	assert.NotContains(t, textContent.Text, prompt.LoadRelevantStandardsPrompt())
+}
+
+func TestMCP_handleListStandards_InvalidNamesOnly(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{"names_only": "yes"}
+
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "list_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", nil, gomock.Any())
+
+	result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), "names_only must be a boolean")
+}
+
+func TestMCP_handleGetStandards_TemplateVars(t *testing.T) {
+	t.Setenv("TEST_REGISTRY_URL", "registry.example.com")
+	t.Setenv("TEST_SECRET_TOKEN", "secret")
+
+	content := "Push images to ${TEST_REGISTRY_URL} using ${TEST_SECRET_TOKEN} and ${TEST_UNSET_VAR}"
+
+	tests := []struct {
+		name         string
+		templateVars string
+		expected     string
+	}{
+		{
+			name:         "templating disabled",
+			templateVars: "",
+			expected:     content,
+		},
+		{
+			name:         "allowlisted variable expanded, others left verbatim",
+			templateVars: "TEST_REGISTRY_URL, TEST_UNSET_VAR",
+			expected:     "Push images to registry.example.com using ${TEST_SECRET_TOKEN} and ${TEST_UNSET_VAR}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.TemplateVars = tt.templateVars
+
+			ctx := context.Background()
+			input := map[string]any{"standard_names": []string{"registry"}}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(ctx, []string{"registry"}).
+				Return([]domain.Standard{createTestStandard("registry", "Registry", content)}, nil, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			assert.Contains(t, textContent.Text, "```md\n"+tt.expected+"\n```")
+		})
+	}
+}
+
+func TestMCP_handleGetStandards_ContentWrapper(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		suffix   string
+		expected string
+	}{
+		{
+			name:     "built-in format",
+			prefix:   "",
+			suffix:   "",
+			expected: "## golang: Go rules\n```md\nUse gofmt\n```",
+		},
+		{
+			name:     "xml wrapper",
+			prefix:   `<standard name="{name}" description="{description}">`,
+			suffix:   "</standard>",
+			expected: "<standard name=\"golang\" description=\"Go rules\">\nUse gofmt\n</standard>",
+		},
+		{
+			name:     "prefix only",
+			prefix:   "# {name}",
+			suffix:   "",
+			expected: "# golang\nUse gofmt",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.ContentPrefix = tt.prefix
+			server.cfg.ContentSuffix = tt.suffix
+
+			ctx := context.Background()
+			input := map[string]any{"standard_names": []string{"golang"}}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(ctx, []string{"golang"}).
+				Return([]domain.Standard{createTestStandard("golang", "Go rules", "Use gofmt")}, nil, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			assert.True(t, strings.HasSuffix(textContent.Text, "\n\n"+tt.expected), textContent.Text)
+		})
+	}
+}
+
+func TestDisplayName(t *testing.T) {
+	tests := []struct {
+		name         string
+		standardName string
+		displayNames config.DisplayNames
+		expected     string
+	}{
+		{"canonical", "error-handling", config.DisplayNamesCanonical, "error-handling"},
+		{"titlecase with dashes", "error-handling", config.DisplayNamesTitlecase, "error-handling (Error Handling)"},
+		{"titlecase with underscores", "error_handling", config.DisplayNamesTitlecase, "error_handling (Error Handling)"},
+		{"titlecase only", "error-handling", config.DisplayNamesTitlecaseOnly, "Error Handling"},
+		{"nested standard", "go/error_handling", config.DisplayNamesTitlecaseOnly, "Go / Error Handling"},
+		{"dots and repeated separators", "api.rest__v2", config.DisplayNamesTitlecaseOnly, "Api Rest V2"},
+		{"non-ASCII", "ошибки", config.DisplayNamesTitlecaseOnly, "Ошибки"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, displayName(tt.standardName, tt.displayNames))
+		})
+	}
+}
+
+func TestFormatStandardInfo_DisplayNames(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	info := domain.StandardInfo{Name: "error-handling", Description: "Wrap errors", Languages: []string{"en", "ru"}}
+
+	assert.Equal(t, "error-handling (Error Handling) [en, ru]: Wrap errors",
+		formatStandardInfo(info, now, "", config.DisplayNamesTitlecase))
+	assert.Equal(t, "Error Handling [en, ru]: Wrap errors",
+		formatStandardInfo(info, now, "", config.DisplayNamesTitlecaseOnly))
+}
+
+func TestFormatStandard_ShowSize(t *testing.T) {
+	standard := createTestStandard("golang", "Go rules", "Use gofmt\nRun go vet\nWrap errors")
+
+	tests := []struct {
+		name     string
+		wrapper  contentWrapper
+		expected string
+	}{
+		{
+			name:     "built-in heading",
+			wrapper:  contentWrapper{prefix: "", suffix: "", showSize: true},
+			expected: "## golang: Go rules (3 lines, 32 bytes)\n```md\nUse gofmt\nRun go vet\nWrap errors\n```",
+		},
+		{
+			name:     "hidden by default",
+			wrapper:  contentWrapper{prefix: "", suffix: "", showSize: false},
+			expected: "## golang: Go rules\n```md\nUse gofmt\nRun go vet\nWrap errors\n```",
+		},
+		{
+			name:     "custom wrapper",
+			wrapper:  contentWrapper{prefix: "<standard description=\"{description}\">", suffix: "</standard>", showSize: true},
+			expected: "<standard description=\"Go rules (3 lines, 32 bytes)\">\nUse gofmt\nRun go vet\nWrap errors\n</standard>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatStandard(standard, tt.wrapper))
+		})
+	}
+}
+
+func TestLineCount(t *testing.T) {
+	assert.Equal(t, 0, lineCount(""))
+	assert.Equal(t, 1, lineCount("one"))
+	assert.Equal(t, 1, lineCount("one\n"))
+	assert.Equal(t, 3, lineCount("one\n\nthree"))
+}
+
+func TestMCP_handleGetStandards_ShowSize(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{"standard_names": []string{"golang"}, "show_size": true}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandards(ctx, []string{"golang"}).
+		Return([]domain.Standard{createTestStandard("golang", "Go rules", "Use gofmt\nRun go vet")}, nil, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+	assert.Contains(t, outputOf(result).Result, "## golang: Go rules (2 lines, 20 bytes)\n")
+}
+
+func TestMCP_handleGetStandards_TrailingNewline(t *testing.T) {
+	// Content ending with blank lines and a custom prefix without suffix leave trailing whitespace
+	standards := []domain.Standard{
+		createTestStandard("golang", "Go rules", "Use gofmt\n\n"),
+		createTestStandard("python", "Python rules", "Use black \n"),
+	}
+
+	tests := []struct {
+		name            string
+		standards       []domain.Standard
+		trailingNewline bool
+		expectedSuffix  string
+	}{
+		{name: "single without newline", standards: standards[:1], trailingNewline: false, expectedSuffix: "Use gofmt"},
+		{name: "multiple without newline", standards: standards, trailingNewline: false, expectedSuffix: "Use black"},
+		{name: "single with newline", standards: standards[:1], trailingNewline: true, expectedSuffix: "Use gofmt\n"},
+		{name: "multiple with newline", standards: standards, trailingNewline: true, expectedSuffix: "Use black\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.ContentPrefix = "# {name}"
+			server.cfg.TrailingNewline = tt.trailingNewline
+
+			ctx := context.Background()
+			names := make([]string, 0, len(tt.standards))
+			for _, standard := range tt.standards {
+				names = append(names, standard.Name)
+			}
+			input := map[string]any{"standard_names": names}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(gomock.Any(), names).
+				Return(tt.standards, nil, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+
+			text := outputOf(result).Result
+			assert.True(t, strings.HasSuffix(text, tt.expectedSuffix), "unexpected result end: %q", text)
+		})
+	}
+}
+
+func TestMCP_handleGetStandards_EmptyGet(t *testing.T) {
+	tests := []struct {
+		name              string
+		emptyGet          config.EmptyGet
+		expectedText      string
+		expectedStandards []listedStandard
+	}{
+		{name: "not found", emptyGet: config.EmptyGetNotFound, expectedText: "No standards found.", expectedStandards: nil},
+		{name: "message", emptyGet: config.EmptyGetMessage, expectedText: noNamesRequestedMessage, expectedStandards: nil},
+		{name: "empty", emptyGet: config.EmptyGetEmpty, expectedText: "", expectedStandards: []listedStandard{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.EmptyGet = string(tt.emptyGet)
+
+			ctx := context.Background()
+			input := map[string]any{"standard_names": []string{}}
+
+			// Only the not-found response asks the loader
+			if tt.emptyGet == config.EmptyGetNotFound {
+				server.standardLoader.(*MockStandardLoader).EXPECT().
+					GetStandards(gomock.Any(), []string{}).
+					Return(nil, nil, nil)
+			}
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", tt.expectedText, nil)
+
+			result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+			assert.False(t, result.IsError)
+
+			output := outputOf(result)
+			assert.Equal(t, tt.expectedText, output.Result)
+			assert.Equal(t, tt.expectedStandards, output.Standards)
+		})
+	}
+}
+
+func TestMCP_runCacheStats(t *testing.T) {
+	tests := []struct {
+		name string
+		stop func(server *MCP, cancel context.CancelFunc)
+	}{
+		{"Stopped by Stop", func(server *MCP, _ context.CancelFunc) {
+			server.logger.(*shared.MockLogger).EXPECT().Info("Stopping MCP server")
+			require.NoError(t, server.Stop(context.Background()))
+		}},
+		{"Stopped by context cancellation", func(_ *MCP, cancel context.CancelFunc) { cancel() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			logger := shared.NewMockLogger(ctrl)
+			stats := NewMockCacheStatsProvider(ctrl)
+			loader := struct {
+				*MockStandardLoader
+				*MockCacheStatsProvider
+			}{NewMockStandardLoader(ctrl), stats}
+			cfg := createTestConfig()
+			cfg.StatsInterval = 5 * time.Millisecond
+
+			server, err := New(cfg, logger, shared.NewMockAuditLogger(ctrl), loader, testBuildInfo())
+			require.NoError(t, err)
+
+			logged := make(chan struct{}, 1)
+			stats.EXPECT().CacheStats().Return(domain.CacheStats{Hits: 3, Misses: 1, Entries: 2}).MinTimes(1)
+			logger.EXPECT().
+				Info("Cache statistics", "hits", int64(3), "misses", int64(1), "hit_ratio", 0.75, "entries", 2).
+				Do(func(string, ...any) {
+					select {
+					case logged <- struct{}{}:
+					default:
+					}
+				}).
+				MinTimes(1)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			done := make(chan struct{})
+			go func() {
+				server.runCacheStats(ctx)
+				close(done)
+			}()
+
+			select {
+			case <-logged:
+			case <-time.After(time.Second):
+				t.Fatal("cache statistics were not logged")
+			}
+
+			tt.stop(server, cancel)
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("cache statistics logging did not stop")
+			}
+		})
+	}
+}
+
+func TestMCP_limitRequestDuration(t *testing.T) {
+	tests := []struct {
+		name          string
+		loaderDelay   time.Duration
+		expectTimeout bool
+	}{
+		{name: "slow loader", loaderDelay: time.Hour, expectTimeout: true},
+		{name: "fast loader", loaderDelay: 0, expectTimeout: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.RequestDeadline = 20 * time.Millisecond
+
+			ctx := context.Background()
+			input := map[string]any{"standard_names": []string{"golang"}}
+
+			// The loader returns a partial result once the deadline aborts it
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(gomock.Any(), []string{"golang"}).
+				DoAndReturn(func(ctx context.Context, _ []string) ([]domain.Standard, []domain.SkippedStandard, error) {
+					select {
+					case <-ctx.Done():
+					case <-time.After(tt.loaderDelay):
+					}
+					return []domain.Standard{createTestStandard("golang", "Go rules", "Use gofmt")}, nil, nil
+				})
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+			if tt.expectTimeout {
+				server.logger.(*shared.MockLogger).EXPECT().
+					Warn("Tool call exceeded the request deadline", gomock.Any())
+				// The error replacing the partial result is audited as well
+				server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+					LogClientResponse("mcp-client", nil, gomock.Any()).
+					Do(func(_ string, _ any, err error) {
+						assert.ErrorIs(t, err, context.DeadlineExceeded)
+					})
+			}
+
+			handler := server.limitRequestDuration(func(ctx context.Context, _ string, req mcp.Request) (mcp.Result, error) {
+				return server.handleGetStandards(ctx, req.(*mcp.CallToolRequest), input)
+			})
+			request := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "get_standards"}}
+
+			result, err := handler(ctx, "tools/call", request)
+			require.NoError(t, err)
+
+			toolResult, ok := result.(*mcp.CallToolResult)
+			require.True(t, ok)
+			text := outputOf(toolResult).Result
+			if tt.expectTimeout {
+				assert.True(t, toolResult.IsError)
+				assert.Equal(t, "tool call exceeded the request deadline of 20ms: context deadline exceeded", text)
+				return
+			}
+			assert.False(t, toolResult.IsError)
+			assert.Contains(t, text, "Use gofmt")
+		})
+	}
+}
+
+func TestNormalizeTrailingNewline(t *testing.T) {
+	assert.Equal(t, "text", normalizeTrailingNewline("text\n\n \t", false))
+	assert.Equal(t, "text\n", normalizeTrailingNewline("text\n\n \t", true))
+	assert.Equal(t, "text\n", normalizeTrailingNewline("text", true))
+}
+
+func TestMCP_handleGetStandards_SingleStringName(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{
+		"standard_names": "standard1", // A lone string instead of an array
+	}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandards(ctx, []string{"standard1"}).
+		Return([]domain.Standard{createTestStandard("standard1", "Description 1", "Content 1")}, nil, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Contains(t, textContent.Text, "## standard1: Description 1")
+	assert.Contains(t, textContent.Text, "Content 1")
+}
+
+func TestMCP_handleGetStandards_LoggerInContext(t *testing.T) {
+	tests := []struct {
+		name         string
+		logLevel     string
+		clientLogs   string
+		strict       bool
+		expectLogger bool
+	}{
+		{"error level", "ERROR", "", true, false},
+		{"debug level", "DEBUG", "", true, true},
+		{"warn level", "WARN", "", true, true},
+		{"debug client logs", "ERROR", "debug", true, true},
+		{"error client logs", "ERROR", "error", true, false},
+		{"oversized standards skipped", "WARN", "", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.LogLevel = tt.logLevel
+			server.cfg.ClientLogs = tt.clientLogs
+			server.cfg.Strict = tt.strict
+
+			input := map[string]any{"standard_names": []string{"missing"}}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(gomock.Any(), []string{"missing"}).
+				DoAndReturn(func(ctx context.Context, _ []string) ([]domain.Standard, []domain.SkippedStandard, error) {
+					// The request has no session, so the request logger is the server logger
+					assert.Equal(t, tt.expectLogger, shared.LoggerFrom(ctx) == server.logger)
+					return []domain.Standard{}, nil, nil
+				})
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			_, err := server.handleGetStandards(context.Background(), &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMCP_handleListStandards_ConfiguredNoResultsPrompt(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+	server.cfg.NoResultsPrompt = "Ask the user which tags they meant."
+
+	ctx := context.Background()
+	input := map[string]any{"tags": "java"}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		ListStandards(ctx).
+		Return([]domain.StandardInfo{createTestStandardInfo("golang", "Go rules")}, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "list_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, "No standards found.\n\nAsk the user which tags they meant.", textContent.Text)
+}
+
+func TestFilterByTags(t *testing.T) {
+	tagged := func(name string, tags ...string) domain.StandardInfo {
+		info := createTestStandardInfo(name, name)
+		info.Tags = tags
+		return info
+	}
+
+	infos := []domain.StandardInfo{
+		tagged("go-security", "security", "go"),
+		tagged("go-style", "Go", "style"),
+		tagged("api-security", "security", "api"),
+		tagged("untagged"),
+	}
+
+	tests := []struct {
+		name     string
+		tags     []string
+		match    string
+		expected []string
+	}{
+		{"No tags keep all", nil, "", []string{"go-security", "go-style", "api-security", "untagged"}},
+		{"Any is the default", []string{"security", "go"}, "", []string{"go-security", "go-style", "api-security"}},
+		{"Any", []string{"api", "style"}, tagMatchAny, []string{"go-style", "api-security"}},
+		{"All", []string{"security", "go"}, tagMatchAll, []string{"go-security"}},
+		{"All is case-insensitive", []string{"GO", "Style"}, tagMatchAll, []string{"go-style"}},
+		{"All with no match", []string{"security", "style"}, tagMatchAll, []string{}},
+		{"Unknown tag", []string{"python"}, tagMatchAny, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := filterByTags(slices.Clone(infos), tt.tags, tt.match)
+
+			names := make([]string, 0, len(result))
+			for _, info := range result {
+				names = append(names, info.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestMCP_handleListStandards_Tags(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       map[string]any
+		expected    string
+		expectError bool
+	}{
+		{
+			"Tags match any",
+			map[string]any{"names_only": true, "tags": []any{"security", "go"}},
+			"go-security\ngo-style\napi-security",
+			false,
+		},
+		{
+			"Tags match all",
+			map[string]any{"names_only": true, "tags": []any{"security", "go"}, "tag_match": "all"},
+			"go-security",
+			false,
+		},
+		{
+			"Single tag string",
+			map[string]any{"names_only": true, "tags": "api"},
+			"api-security",
+			false,
+		},
+		{
+			"No tag matches",
+			map[string]any{"names_only": true, "tags": "java"},
+			"No standards found.\n\n" + prompt.NoResultsPrompt(),
+			false,
+		},
+		{"Invalid tag match", map[string]any{"tags": "go", "tag_match": "most"}, "", true},
+		{"Invalid tags", map[string]any{"tags": []any{"go", 1}}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+
+			goSecurity := createTestStandardInfo("go-security", "Go security")
+			goSecurity.Tags = []string{"security", "go"}
+			goStyle := createTestStandardInfo("go-style", "Go style")
+			goStyle.Tags = []string{"go", "style"}
+			apiSecurity := createTestStandardInfo("api-security", "API security")
+			apiSecurity.Tags = []string{"security", "api"}
+
+			ctx := context.Background()
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "list_standards", tt.input)
+
+			if tt.expectError {
+				server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+					LogClientResponse("mcp-client", nil, gomock.Any())
+
+				result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, tt.input)
+				require.Error(t, err)
+				assert.True(t, result.IsError)
+				return
+			}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(ctx).
+				Return([]domain.StandardInfo{goSecurity, goStyle, apiSecurity}, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, tt.input)
+			require.NoError(t, err)
+
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, textContent.Text)
+		})
+	}
+}
+
+func TestMCP_handleListStandards_Context(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       map[string]any
+		expected    string
+		expectError bool
+	}{
+		{"No context applies all", map[string]any{"names_only": true}, "general\ngo-errors\nnot-python", false},
+		{
+			"Empty context applies all",
+			map[string]any{"names_only": true, "context": map[string]any{}},
+			"general\ngo-errors\nnot-python",
+			false,
+		},
+		{
+			"Matching context",
+			map[string]any{"names_only": true, "context": map[string]any{"language": "go"}},
+			"general\ngo-errors\nnot-python",
+			false,
+		},
+		{
+			"Other context",
+			map[string]any{"names_only": true, "context": map[string]any{"language": "python"}},
+			"general",
+			false,
+		},
+		{
+			"Missing context key",
+			map[string]any{"names_only": true, "context": map[string]any{"framework": "gin"}},
+			"general\nnot-python",
+			false,
+		},
+		{"Invalid context", map[string]any{"context": []any{"go"}}, "", true},
+		{"Invalid context value", map[string]any{"context": map[string]any{"language": []any{"go"}}}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+
+			general := createTestStandardInfo("general", "General rules")
+			goErrors := createTestStandardInfo("go-errors", "Go errors")
+			goErrors.When = "language == go"
+			notPython := createTestStandardInfo("not-python", "Not for Python")
+			notPython.When = "language != python"
+
+			ctx := context.Background()
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "list_standards", tt.input)
+
+			if tt.expectError {
+				server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+					LogClientResponse("mcp-client", nil, gomock.Any())
+
+				result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, tt.input)
+				require.Error(t, err)
+				assert.True(t, result.IsError)
+				return
+			}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(ctx).
+				Return([]domain.StandardInfo{general, goErrors, notPython}, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, outputOf(result).Result)
+		})
+	}
+}
+
+func TestMCP_handleGetStandards_Context(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	goErrors := createTestStandard("go-errors", "Go errors", "Wrap errors")
+	goErrors.When = "language == go"
+	general := createTestStandard("general", "General rules", "Be consistent")
+
+	ctx := context.Background()
+	input := map[string]any{
+		"standard_names": []string{"go-errors", "general"},
+		"context":        map[string]any{"language": "python"},
+	}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandards(gomock.Any(), []string{"go-errors", "general"}).
+		Return([]domain.Standard{goErrors, general}, nil, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+
+	output := outputOf(result)
+	assert.Contains(t, output.Result, "Be consistent")
+	assert.NotContains(t, output.Result, "Wrap errors")
+	assert.Equal(t, []string{"standard go-errors not found"}, output.Warnings)
+}
+
+func TestMCP_handleListStandards_Allowlist(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowlist string
+		expected  string
+	}{
+		{"Empty allowlist serves all", "", "general\ngo-errors\ngo-testing\npython-style"},
+		{"Explicit names", "general, python-style", "general\npython-style"},
+		{"Glob", "go-*", "go-errors\ngo-testing"},
+		{"Names and globs", "general,go-t*", "general\ngo-testing"},
+		{"Nothing matches", "java-*", "No standards found."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.Allowlist = tt.allowlist
+
+			ctx := context.Background()
+			input := map[string]any{"names_only": true}
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(ctx).
+				Return([]domain.StandardInfo{
+					createTestStandardInfo("general", "General rules"),
+					createTestStandardInfo("go-errors", "Go errors"),
+					createTestStandardInfo("go-testing", "Go testing"),
+					createTestStandardInfo("python-style", "Python style"),
+				}, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "list_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, outputOf(result).Result)
+		})
+	}
+}
+
+func TestMCP_handleGetStandards_Allowlist(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+	server.cfg.Allowlist = "go-*,general"
+
+	ctx := context.Background()
+	input := map[string]any{"standard_names": []string{"go-errors", "python-style", "general"}}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandards(gomock.Any(), []string{"go-errors", "general"}).
+		Return([]domain.Standard{
+			createTestStandard("go-errors", "Go errors", "Wrap errors"),
+			createTestStandard("general", "General rules", "Be consistent"),
+		}, nil, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+
+	output := outputOf(result)
+	assert.Contains(t, output.Result, "Wrap errors")
+	assert.Contains(t, output.Result, "Be consistent")
+	assert.Equal(t, []string{"standard python-style not found"}, output.Warnings)
+}
+
+func TestMCP_handleListStandards_Disabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]any
+		expected string
+	}{
+		{"Disabled standards are hidden", map[string]any{"names_only": true}, "published"},
+		{
+			"Disabled standards are included on request",
+			map[string]any{"names_only": true, "include_disabled": true},
+			"draft\npublished",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+
+			draft := createTestStandardInfo("draft", "Draft")
+			draft.Disabled = true
+
+			ctx := context.Background()
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(ctx).
+				Return([]domain.StandardInfo{draft, createTestStandardInfo("published", "Published")}, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "list_standards", tt.input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, tt.input)
+			require.NoError(t, err)
+
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, textContent.Text)
+		})
+	}
+}
+
+func TestMCP_handleGetStandards_Disabled(t *testing.T) {
+	tests := []struct {
+		name            string
+		includeDisabled bool
+		expectFound     bool
+	}{
+		{"Disabled standard is not served", false, false},
+		{"Disabled standard is served on request", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+
+			draft := createTestStandard("draft", "Draft", "Draft content")
+			draft.Disabled = true
+
+			ctx := context.Background()
+			input := map[string]any{"standard_names": []string{"draft"}, "include_disabled": tt.includeDisabled}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(ctx, []string{"draft"}).
+				Return([]domain.Standard{draft}, nil, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			if tt.expectFound {
+				assert.Contains(t, textContent.Text, "Draft content")
+				return
+			}
+			assert.True(t, strings.HasPrefix(textContent.Text, "No standards found."))
+		})
+	}
+}
+
+func TestMCP_handleGetStandards_TooManyNames(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+	server.cfg.MaxGetNames = 2
+
+	ctx := context.Background()
+	input := map[string]any{"standard_names": []string{"standard1", "standard2", "standard3"}}
+
+	// The loader must not be called
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", nil, gomock.Any())
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), "too many standard names: 3 (maximum is 2 per call)")
+}
+
+func TestMCP_handleGetStandards_NotFoundWarning(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{"standard_names": []string{"standard1", "missing"}}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandards(ctx, []string{"standard1", "missing"}).
+		Return([]domain.Standard{createTestStandard("standard1", "Description 1", "Content 1")}, nil, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+
+	// Warnings are reported in the structured output only
+	output := outputOf(result)
+	assert.Equal(t, []string{"standard missing not found"}, output.Warnings)
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, textContent.Text, output.Result)
+	assert.NotContains(t, textContent.Text, "missing")
+}
+
+func TestMCP_handleGetStandards_SkippedWarning(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{"standard_names": []string{"standard1", "large"}}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandards(ctx, []string{"standard1", "large"}).
+		Return([]domain.Standard{createTestStandard("standard1", "Description 1", "Content 1")},
+			[]domain.SkippedStandard{{Name: "large", Reason: domain.ErrStandardSkipped.Error()}}, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+
+	// A skipped standard is not reported as not found
+	output := outputOf(result)
+	assert.Equal(t, []string{"standard large skipped: exceeds AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE"}, output.Warnings)
+}
+
+func TestMCP_handleListStandards_StaleWarning(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	stale := createTestStandardInfo("stale", "Stale standard")
+	stale.ReviewBy = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	ctx := context.Background()
+	input := map[string]any{}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		ListStandards(ctx).
+		Return([]domain.StandardInfo{stale, createTestStandardInfo("fresh", "Fresh standard")}, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "list_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleListStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"standard stale is past its review date 2020-01-01"}, outputOf(result).Warnings)
+}
+
+func TestMCP_handleGetStandards_ResponseSizeLimit(t *testing.T) {
+	tests := []struct {
+		name            string
+		truncate        bool
+		expectedNames   []string
+		expectedOmitted string
+	}{
+		{
+			name:            "omit whole standards",
+			truncate:        false,
+			expectedNames:   []string{"small", "tiny"},
+			expectedOmitted: "Omitted 1 standard(s) exceeding the response size limit of 64 bytes: large.",
+		},
+		{
+			name:            "truncate content",
+			truncate:        true,
+			expectedNames:   []string{"small", "large"},
+			expectedOmitted: "Omitted 1 standard(s) exceeding the response size limit of 64 bytes: tiny.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.MaxResponseSize = 64
+			server.cfg.TruncateContent = tt.truncate
+
+			ctx := context.Background()
+			input := map[string]any{"standard_names": []string{"small", "large", "tiny"}}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(ctx, []string{"small", "large", "tiny"}).
+				Return([]domain.Standard{
+					createTestStandard("small", "Small", strings.Repeat("s", 20)),
+					createTestStandard("large", "Large", strings.Repeat("l", 100)),
+					createTestStandard("tiny", "Tiny", "t"),
+				}, nil, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+
+			output := outputOf(result)
+			assert.Empty(t, output.Warnings)
+			assert.Contains(t, output.Result, tt.expectedOmitted)
+			for _, name := range tt.expectedNames {
+				assert.Contains(t, output.Result, "## "+name+":")
+			}
+
+			if tt.truncate {
+				// 44 bytes remain after the small standard, the marker takes 28 of them
+				assert.Contains(t, output.Result, strings.Repeat("l", 16)+"\n...[truncated 84 bytes]...")
+				assert.NotContains(t, output.Result, strings.Repeat("l", 17))
+			} else {
+				assert.NotContains(t, output.Result, "## large:")
+			}
+		})
+	}
+}
+
+func TestMCP_handleGetStandards_AllOmitted(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+	server.cfg.MaxResponseSize = 64
+
+	ctx := context.Background()
+	input := map[string]any{"standard_names": []string{"large"}}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandards(ctx, []string{"large"}).
+		Return([]domain.Standard{createTestStandard("large", "Large", strings.Repeat("l", 100))}, nil, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+
+	// The standard exists, only the omitted note is returned
+	output := outputOf(result).Result
+	assert.True(t, strings.HasPrefix(output, "Omitted 1 standard(s) exceeding the response size limit of 64 bytes: large."))
+	assert.NotContains(t, output, "No standards found")
+}
+
+func TestTruncateContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		maxBytes int
+		expected string
+		ok       bool
+	}{
+		{"ascii", "abcdefghij" + strings.Repeat("x", 30), 30, "abc\n...[truncated 37 bytes]...", true},
+		{"rune boundary", "aбвгд" + strings.Repeat("x", 30), 31, "aб\n...[truncated 36 bytes]...", true},
+		{"marker does not fit", strings.Repeat("x", 100), 10, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			truncated, ok := truncateContent(tt.content, tt.maxBytes)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, truncated)
+			assert.LessOrEqual(t, len(truncated), tt.maxBytes)
+			assert.True(t, utf8.ValidString(truncated))
+		})
+	}
+}
+
+func TestMCP_handleGetStandards_BudgetUnit(t *testing.T) {
+	// 40 Cyrillic characters take 80 bytes but the same 10 estimated tokens as 40 ASCII characters
+	first := strings.Repeat("ж", 40)
+	second := strings.Repeat("s", 40)
+
+	tests := []struct {
+		name            string
+		unit            config.BudgetUnit
+		limit           int
+		expectedNames   []string
+		expectedOmitted string
+	}{
+		{
+			name:            "bytes",
+			unit:            config.BudgetUnitBytes,
+			limit:           60,
+			expectedNames:   []string{"second"},
+			expectedOmitted: "Omitted 1 standard(s) exceeding the response size limit of 60 bytes: first.",
+		},
+		{
+			name:            "tokens fit",
+			unit:            config.BudgetUnitTokens,
+			limit:           60,
+			expectedNames:   []string{"first", "second"},
+			expectedOmitted: "",
+		},
+		{
+			name:          "tokens exceeded",
+			unit:          config.BudgetUnitTokens,
+			limit:         15,
+			expectedNames: []string{"first"},
+			expectedOmitted: "Omitted 1 standard(s) exceeding the response size limit of 15 tokens: second. " +
+				"Call get_standards with fewer names to get them. They are about 10 tokens.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.MaxResponseSize = config.ByteSize(tt.limit)
+			server.cfg.BudgetUnit = string(tt.unit)
+
+			ctx := context.Background()
+			input := map[string]any{"standard_names": []string{"first", "second"}}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(ctx, []string{"first", "second"}).
+				Return([]domain.Standard{
+					createTestStandard("first", "First", first),
+					createTestStandard("second", "Second", second),
+				}, nil, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+
+			output := outputOf(result)
+			for _, name := range tt.expectedNames {
+				assert.Contains(t, output.Result, "## "+name+":")
+			}
+			if tt.expectedOmitted == "" {
+				assert.NotContains(t, output.Result, "Omitted")
+				return
+			}
+			assert.Contains(t, output.Result, tt.expectedOmitted)
+		})
+	}
+}
+
+func TestResponseBudget_TruncateTokens(t *testing.T) {
+	budget := responseBudget{limit: 10, truncate: true, unit: config.BudgetUnitTokens, charsPerToken: 4}
+
+	// 10 tokens are 40 characters, of which the marker takes 34
+	truncated, ok := budget.truncateContent(strings.Repeat("ж", 100), 10)
+	require.True(t, ok)
+	assert.Equal(t, strings.Repeat("ж", 6)+"\n...[truncated about 24 tokens]...", truncated)
+	assert.LessOrEqual(t, budget.size(truncated), 10)
+
+	_, ok = budget.truncateContent(strings.Repeat("x", 100), 5)
+	assert.False(t, ok, "the marker does not fit into 5 tokens")
+}
+
+func TestMCP_handleGetStandards_MaxBytes(t *testing.T) {
+	tests := []struct {
+		name            string
+		serverMax       int
+		maxBytes        any
+		expectedOmitted string
+	}{
+		{"lowers unlimited server limit", 0, 30, "Omitted 1 standard(s) exceeding the response size limit of 30 bytes: second."},
+		{"lowers server limit", 100, float64(30), "Omitted 1 standard(s) exceeding the response size limit of 30 bytes: second."},
+		{"clamped to server limit", 30, 1000, "Omitted 1 standard(s) exceeding the response size limit of 30 bytes: second."},
+		{"zero keeps server limit", 100, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+			server.cfg.MaxResponseSize = config.ByteSize(tt.serverMax)
+
+			ctx := context.Background()
+			input := map[string]any{"standard_names": []string{"first", "second"}, "max_bytes": tt.maxBytes}
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				GetStandards(ctx, []string{"first", "second"}).
+				Return([]domain.Standard{
+					createTestStandard("first", "First", strings.Repeat("f", 20)),
+					createTestStandard("second", "Second", strings.Repeat("s", 20)),
+				}, nil, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "get_standards", input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", gomock.Any(), nil)
+
+			result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+			require.NoError(t, err)
+
+			output := outputOf(result)
+			assert.Contains(t, output.Result, "## first:")
+			if tt.expectedOmitted == "" {
+				assert.Contains(t, output.Result, "## second:")
+				assert.NotContains(t, output.Result, "Omitted")
+				return
+			}
+			assert.NotContains(t, output.Result, "## second:")
+			assert.Contains(t, output.Result, tt.expectedOmitted)
+		})
+	}
+}
+
+func TestMCP_handleGetStandards_TableOfContents(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+	server.cfg.DefaultLanguage = "en"
+
+	ctx := context.Background()
+	input := map[string]any{
+		"standard_names": []string{"errors", "security"},
+		"sort":           sortByPriority,
+		"include_toc":    true,
+	}
+
+	errorsEN := createTestStandard("errors", "Errors", "english errors")
+	errorsEN.Language = "en"
+	errorsEN.Priority = 0.5
+	errorsRU := createTestStandard("errors", "Errors", "russian errors")
+	errorsRU.Language = "ru"
+	errorsRU.Priority = 0.5
+	security := createTestStandard("security", "Security", "security rules")
+	security.Priority = 0.9
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandards(ctx, []string{"errors", "security"}).
+		Return([]domain.Standard{errorsEN, errorsRU, security}, nil, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	output := outputOf(result)
+	assert.Contains(t, output.Result, prompt.FollowStandardsPrompt()+"\n\nContents:\n1. security\n2. errors\n\n------\n\n")
+	assert.Less(t, strings.Index(output.Result, "## security:"), strings.Index(output.Result, "## errors:"))
+}
+
+func TestMCP_handleGetStandards_NoTableOfContentsByDefault(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{"standard_names": []string{"errors"}}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandards(ctx, []string{"errors"}).
+		Return([]domain.Standard{createTestStandard("errors", "Errors", "error rules")}, nil, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+	assert.NotContains(t, outputOf(result).Result, "Contents:")
+}
+
+func TestMCP_handleGetStandards_DocumentFormat(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{
+		"standard_names": []string{"go/errors", "security"},
+		"format":         formatDocument,
+	}
+
+	server.standardLoader.(*MockStandardLoader).EXPECT().
+		GetStandards(ctx, []string{"go/errors", "security"}).
+		Return([]domain.Standard{
+			createTestStandard("go/errors", "Errors", "# Error Rules\n\n```sh\n# comment\n```"),
+			createTestStandard("security", "Security", "security rules"),
+		}, nil, nil)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", gomock.Any(), nil)
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	output := outputOf(result).Result
+	assert.Contains(t, output, "## Contents\n\n- [go/errors](#go-errors)\n- [security](#security)")
+	// Top-level headings of the content are demoted, headings in code blocks are left as is
+	assert.Contains(t, output, "<a id=\"go-errors\"></a>\n\n## go/errors\n\nErrors\n\n## Error Rules\n\n```sh\n# comment\n```")
+	assert.Contains(t, output, "<a id=\"security\"></a>\n\n## security\n\nSecurity\n\nsecurity rules")
+	assert.Less(t, strings.Index(output, "## go/errors"), strings.Index(output, "## security"))
+}
+
+func TestMCP_handleReadFrontmatterSchema(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "read_resource", map[string]any{"uri": "schema://frontmatter"})
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", `{"type":"object"}`, nil)
+
+	request := &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: "schema://frontmatter"}}
+	result, err := server.handleReadFrontmatterSchema(`{"type":"object"}`)(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.JSONEq(t, `{"type":"object"}`, result.Contents[0].Text)
+}
+
+func TestToolOutputSchema(t *testing.T) {
+	schema := toolOutputSchema("Tags", outputTags)
+
+	properties, ok := schema["properties"].(map[string]any)
+	require.True(t, ok)
+	assert.ElementsMatch(t, []string{"result", "input", "tags"}, slices.Collect(maps.Keys(properties)))
+	assert.NotNil(t, properties["tags"])
+}
+
+func TestStandardAnchors(t *testing.T) {
+	standards := []domain.Standard{
+		createTestStandard("Go Errors", "", ""),
+		createTestStandard("go-errors", "", ""),
+		createTestStandard("---", "", ""),
+	}
+
+	assert.Equal(t, []string{"go-errors", "go-errors-1", "standard"}, standardAnchors(standards))
+}
+
+func TestMCP_handleGetStandards_InvalidMaxBytes(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	input := map[string]any{"standard_names": []string{"first"}, "max_bytes": -1}
+
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientRequest("mcp-client", "get_standards", input)
+	server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+		LogClientResponse("mcp-client", nil, gomock.Any())
+
+	result, err := server.handleGetStandards(ctx, &mcp.CallToolRequest{}, input)
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), "max_bytes must not be negative")
+}
+
+func TestMCP_RegisterTools_Annotations(t *testing.T) {
+	server, ctrl := createTestServer(t)
+	defer ctrl.Finish()
+	server.cfg.ConfigTool = true
+
+	server.logger.(*shared.MockLogger).EXPECT().Info("Registering MCP tools")
+	require.NoError(t, server.RegisterTools())
+
+	ctx := context.Background()
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-agent", Version: "1.0.0", Title: ""}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = clientSession.Close()
+		_ = serverSession.Close()
+	})
+
+	tools, err := clientSession.ListTools(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, tools.Tools, 6)
+
+	// All tools only read standards or the server setup
+	for _, tool := range tools.Tools {
+		require.NotNil(t, tool.Annotations, tool.Name)
+		assert.True(t, tool.Annotations.ReadOnlyHint, tool.Name)
+		assert.True(t, tool.Annotations.IdempotentHint, tool.Name)
+		require.NotNil(t, tool.Annotations.DestructiveHint, tool.Name)
+		assert.False(t, *tool.Annotations.DestructiveHint, tool.Name)
+		require.NotNil(t, tool.Annotations.OpenWorldHint, tool.Name)
+		assert.False(t, *tool.Annotations.OpenWorldHint, tool.Name)
+		assert.Equal(t, tool.Title, tool.Annotations.Title, tool.Name)
+	}
+}
+
+func TestMCP_handleListTags(t *testing.T) {
+	tests := []struct {
+		name            string
+		input           map[string]any
+		expectedTags    []tagCount
+		expectedContent string
+	}{
+		{
+			name:  "enabled standards",
+			input: map[string]any{},
+			expectedTags: []tagCount{
+				{Tag: "go", Count: 2},
+				{Tag: "security", Count: 2},
+				{Tag: "testing", Count: 1},
+			},
+			expectedContent: listTagsPrompt + "\ngo: 2\nsecurity: 2\ntesting: 1",
+		},
+		{
+			name:  "include disabled",
+			input: map[string]any{"include_disabled": true},
+			expectedTags: []tagCount{
+				{Tag: "go", Count: 2},
+				{Tag: "security", Count: 2},
+				{Tag: "draft", Count: 1},
+				{Tag: "testing", Count: 1},
+			},
+			expectedContent: listTagsPrompt + "\ngo: 2\nsecurity: 2\ndraft: 1\ntesting: 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, ctrl := createTestServer(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+
+			security := createTestStandardInfo("security", "Security rules")
+			security.Tags = []string{"Security", "go", "security"}
+			securityRu := createTestStandardInfo("security", "Security rules")
+			securityRu.Language = "ru"
+			securityRu.Tags = []string{"security", "go"}
+			errs := createTestStandardInfo("errors", "Error handling")
+			errs.Tags = []string{" go ", "testing"}
+			secrets := createTestStandardInfo("secrets", "Secrets")
+			secrets.Tags = []string{"security"}
+			draft := createTestStandardInfo("draft", "Draft")
+			draft.Tags = []string{"draft"}
+			draft.Disabled = true
+			untagged := createTestStandardInfo("untagged", "No tags")
+
+			server.standardLoader.(*MockStandardLoader).EXPECT().
+				ListStandards(ctx).
+				Return([]domain.StandardInfo{security, securityRu, errs, secrets, draft, untagged}, nil)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientRequest("mcp-client", "list_tags", tt.input)
+			server.auditLogger.(*shared.MockAuditLogger).EXPECT().
+				LogClientResponse("mcp-client", tt.expectedContent, nil)
+
+			result, err := server.handleListTags(ctx, &mcp.CallToolRequest{}, tt.input)
+			require.NoError(t, err)
+
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedContent, textContent.Text)
+			assert.Equal(t, tt.expectedTags, outputOf(result).Tags)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"cmp"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+const (
+	// sortByName orders standards by their name.
+	sortByName = "name"
+	// sortByPath orders standards by their relative path, directory first, then file name.
+	sortByPath = "path"
+	// sortByPriority orders standards by descending frontmatter priority, ties by name.
+	sortByPriority = "priority"
+	// sortByOrder orders standards by ascending frontmatter order, standards without one last, ties by name.
+	sortByOrder = "order"
+	// sortByReviewBy orders standards by the soonest frontmatter review date, standards without one last, ties by name.
+	sortByReviewBy = "review_by"
+)
+
+// sortStandardInfos orders standards in place according to the requested sort mode.
+// An empty or unknown mode keeps the order in which the standards were loaded.
+func sortStandardInfos(infos []domain.StandardInfo, mode string) {
+	switch mode {
+	case sortByName:
+		slices.SortStableFunc(infos, func(a, b domain.StandardInfo) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+	case sortByPath:
+		slices.SortStableFunc(infos, func(a, b domain.StandardInfo) int {
+			if c := strings.Compare(path.Dir(a.Path), path.Dir(b.Path)); c != 0 {
+				return c
+			}
+			return strings.Compare(path.Base(a.Path), path.Base(b.Path))
+		})
+	case sortByOrder:
+		slices.SortStableFunc(infos, func(a, b domain.StandardInfo) int {
+			if c := compareOrder(a.Order, b.Order); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Name, b.Name)
+		})
+	case sortByReviewBy:
+		slices.SortStableFunc(infos, func(a, b domain.StandardInfo) int {
+			if c := compareReviewBy(a.ReviewBy, b.ReviewBy); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Name, b.Name)
+		})
+	}
+}
+
+// compareOrder compares frontmatter orders ascending, an unset order after any set one.
+func compareOrder(a, b *int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	default:
+		return cmp.Compare(*a, *b)
+	}
+}
+
+// compareReviewBy compares review dates chronologically, an unset date after any set one.
+func compareReviewBy(a, b time.Time) int {
+	switch {
+	case a.IsZero() && b.IsZero():
+		return 0
+	case a.IsZero():
+		return 1
+	case b.IsZero():
+		return -1
+	default:
+		return a.Compare(b)
+	}
+}
+
+// sortStandards orders standard contents in place according to the requested sort mode.
+// An empty or unknown mode keeps the requested order.
+func sortStandards(standards []domain.Standard, mode string) {
+	if mode != sortByPriority {
+		return
+	}
+
+	slices.SortStableFunc(standards, func(a, b domain.Standard) int {
+		if c := cmp.Compare(b.Priority, a.Priority); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
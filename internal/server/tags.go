@@ -0,0 +1,37 @@
+package server
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+const (
+	// tagMatchAny keeps standards carrying at least one of the requested tags.
+	tagMatchAny = "any"
+	// tagMatchAll keeps standards carrying all of the requested tags.
+	tagMatchAll = "all"
+)
+
+// filterByTags keeps the standards matching the requested tags according to the match mode.
+// Tags are compared case-insensitively. No requested tags keep all standards.
+func filterByTags(infos []domain.StandardInfo, tags []string, match string) []domain.StandardInfo {
+	if len(tags) == 0 {
+		return infos
+	}
+
+	return slices.DeleteFunc(infos, func(info domain.StandardInfo) bool {
+		hasTag := func(tag string) bool {
+			return slices.ContainsFunc(info.Tags, func(own string) bool {
+				return strings.EqualFold(strings.TrimSpace(own), strings.TrimSpace(tag))
+			})
+		}
+
+		if match == tagMatchAll {
+			// Drop the standard if any requested tag is missing
+			return slices.ContainsFunc(tags, func(tag string) bool { return !hasTag(tag) })
+		}
+		return !slices.ContainsFunc(tags, hasTag)
+	})
+}
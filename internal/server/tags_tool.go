@@ -0,0 +1,146 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// listTagsPrompt introduces the tags returned by the list_tags tool.
+const listTagsPrompt = "Tags of the standards with the number of standards carrying each. " +
+	"Pass them to list_standards as tags to filter the list:"
+
+// tagCount is a tag of the structured list_tags output.
+type tagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// countTags returns the distinct tags of the standards with the number of standards carrying each,
+// most used first, ties by tag. Tags are compared case-insensitively, as by the tags filter.
+func countTags(infos []domain.StandardInfo) []tagCount {
+	counts := make(map[string]int)
+	for _, info := range infos {
+		seen := make(map[string]struct{}, len(info.Tags))
+		for _, tag := range info.Tags {
+			tag = strings.ToLower(strings.TrimSpace(tag))
+			if _, ok := seen[tag]; ok || tag == "" {
+				continue
+			}
+			seen[tag] = struct{}{}
+			counts[tag]++
+		}
+	}
+
+	tags := make([]tagCount, 0, len(counts))
+	for tag, count := range counts {
+		tags = append(tags, tagCount{Tag: tag, Count: count})
+	}
+	slices.SortFunc(tags, func(a, b tagCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Tag, b.Tag)
+	})
+
+	return tags
+}
+
+// formatTagCounts formats tags with their counts as plain text, one per line.
+func formatTagCounts(tags []tagCount) string {
+	if len(tags) == 0 {
+		return "No tags found."
+	}
+
+	var builder strings.Builder
+	builder.WriteString(listTagsPrompt)
+	for _, tag := range tags {
+		builder.WriteString(fmt.Sprintf("\n%s: %d", tag.Tag, tag.Count))
+	}
+
+	return builder.String()
+}
+
+// registerTagsTool registers the list_tags tool with the MCP server.
+func (s *MCP) registerTagsTool() {
+	listTagsInputSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"include_disabled": map[string]any{
+				"type":        "boolean",
+				"description": "Optional flag to also count the tags of standards disabled by their frontmatter",
+			},
+		},
+	}
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name: "list_tags",
+		Description: "Lists the distinct tags of all standards with the number of standards carrying each, " +
+			"most used first. Use it to choose effective tags for filtering list_standards.",
+		InputSchema:  listTagsInputSchema,
+		OutputSchema: toolOutputSchema("{tag}: {number of standards}", outputTags),
+		Meta:         mcp.Meta{},
+		Annotations:  s.readOnlyToolAnnotations("List Tags"),
+		Title:        "List Tags",
+	}, func(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
+		*mcp.CallToolResult, toolOutput, error,
+	) {
+		result, err := s.handleListTags(ctx, request, input)
+		return s.toolResult(result, err, input, listTagsInputSchema)
+	})
+}
+
+// handleListTags handles the list_tags tool request.
+func (s *MCP) handleListTags(ctx context.Context, request *mcp.CallToolRequest, input map[string]any) (
+	*mcp.CallToolResult,
+	error,
+) {
+	metadata := newRequestMetadata(request.Session)
+	s.auditLogger.LogClientRequest(metadata.ClientID, "list_tags", input)
+
+	logger := s.requestLogger(request)
+
+	includeDisabled, err := optionalBool(input, "include_disabled")
+	if err != nil {
+		return s.fail(metadata, err)
+	}
+
+	logger.Debug("Listing tags", "include_disabled", includeDisabled, "client", metadata.ClientID,
+		"session_id", metadata.SessionID)
+
+	domainResult, err := s.standardLoader.ListStandards(s.requestProgress(s.loaderContext(ctx, logger), request))
+	if errors.Is(err, domain.ErrFolderDisappeared) {
+		// Degrade to an empty result, the folder may be mounted again
+		logger.Warn("Serving no standards", "error", err)
+		domainResult, err = nil, nil
+	}
+	if err != nil {
+		logger.Error("Failed to list tags", "error", err)
+		return s.fail(metadata, err)
+	}
+
+	// Language variants of a standard are counted once
+	domainResult = s.filterAllowedInfos(domainResult)
+	domainResult = filterDisabledInfos(domainResult, includeDisabled)
+	domainResult = groupLanguageVariants(domainResult, "", s.cfg.GetDefaultLanguage())
+
+	tags := countTags(domainResult)
+	formattedResult := formatTagCounts(tags)
+
+	s.auditLogger.LogClientResponse(metadata.ClientID, formattedResult, nil)
+	output := newToolOutput(formattedResult)
+	output.Tags = tags
+
+	return &mcp.CallToolResult{
+		IsError:           false,
+		Meta:              mcp.Meta{},
+		Content:           []mcp.Content{&mcp.TextContent{Meta: mcp.Meta{}, Annotations: nil, Text: formattedResult}},
+		StructuredContent: output,
+	}, nil
+}
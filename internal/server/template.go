@@ -0,0 +1,43 @@
+package server
+
+import (
+	"os"
+	"regexp"
+	"slices"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// templateVarPattern matches ${VAR} placeholders in standard content.
+var templateVarPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// expandTemplateVars replaces ${VAR} placeholders in the content of the standards with the values
+// of allowlisted environment variables. Unlisted and unset variables are left verbatim.
+func expandTemplateVars(standards []domain.Standard, allowed []string) []domain.Standard {
+	if len(allowed) == 0 {
+		return standards
+	}
+
+	for i := range standards {
+		standards[i].Content = expandTemplate(standards[i].Content, allowed)
+	}
+
+	return standards
+}
+
+// expandTemplate replaces ${VAR} placeholders of allowlisted and set environment variables in the content.
+func expandTemplate(content string, allowed []string) string {
+	return templateVarPattern.ReplaceAllStringFunc(content, func(placeholder string) string {
+		name := templateVarPattern.FindStringSubmatch(placeholder)[1]
+		if !slices.Contains(allowed, name) {
+			return placeholder
+		}
+
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return placeholder
+		}
+
+		return value
+	})
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+const (
+	// outputWarnings is the warnings field of the structured tool output.
+	outputWarnings = "warnings"
+	// outputStandards is the standards field of the structured tool output.
+	outputStandards = "standards"
+	// outputTags is the tags field of the structured tool output.
+	outputTags = "tags"
+	// outputError is the error field of the structured tool output.
+	outputError = "error"
+)
+
+// toolOutputSchema returns the output schema of a tool returning toolOutput with the result, the echoed input
+// of failed calls and the given optional fields. It must be kept in sync with toolOutput and the fields the tool
+// sets, the SDK validates every structured output against it.
+func toolOutputSchema(resultDescription string, fields ...string) map[string]any {
+	properties := map[string]any{
+		"result": map[string]any{
+			"type":        "string",
+			"description": resultDescription,
+		},
+		"input": map[string]any{
+			"type": "object",
+			"description": "Received input of a failed call with unknown parameters redacted, " +
+				"present only if echoing the input on errors is enabled",
+		},
+	}
+
+	schemas := outputFieldSchemas()
+	for _, field := range fields {
+		properties[field] = schemas[field]
+	}
+
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             []string{"result"},
+		"additionalProperties": false,
+	}
+}
+
+// outputFieldSchemas returns the schemas of the optional fields of the structured tool output by field name.
+func outputFieldSchemas() map[string]any {
+	return map[string]any{
+		outputWarnings: map[string]any{
+			"type":        "array",
+			"items":       map[string]any{"type": "string"},
+			"description": "Non-fatal issues found while handling the request, absent if there are none",
+		},
+		outputStandards: map[string]any{
+			"type": "array",
+			"items": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name":        map[string]any{"type": "string"},
+					"description": map[string]any{"type": "string"},
+				},
+				"required": []string{"name", "description"},
+			},
+			"description": "Listed standards for programmatic use",
+		},
+		outputTags: map[string]any{
+			"type": "array",
+			"items": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"tag":   map[string]any{"type": "string"},
+					"count": map[string]any{"type": "integer"},
+				},
+				"required": []string{"tag", "count"},
+			},
+			"description": "Counted tags for programmatic use",
+		},
+		outputError: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"code": map[string]any{"type": "string", "enum": []string{errorCodeNotFound}},
+				"name": map[string]any{"type": "string"},
+			},
+			"required":    []string{"code", "name"},
+			"description": "Typed error of a failed call, present only if the requested standard does not exist",
+		},
+	}
+}
+
+// toolOutput is the structured output of a tool call.
+// Warnings are kept out of the text content so that it stays clean for the model.
+// Optional fields are omitted when unset, each tool sets the fields declared in its output schema.
+type toolOutput struct {
+	Result   string   `json:"result"`
+	Warnings []string `json:"warnings,omitempty"`
+	// Input is the received input of a failed call, echoed for debugging when enabled.
+	Input map[string]any `json:"input,omitempty"`
+	// Standards are the listed standards of a list_standards call, an empty list if none matched.
+	Standards []listedStandard `json:"standards,omitzero"`
+	// Tags are the counted tags of a list_tags call, an empty list if no standard has tags.
+	Tags []tagCount `json:"tags,omitzero"`
+	// Error is the typed error of a failed call, so that clients can tell a missing standard from other failures.
+	Error *toolError `json:"error,omitempty"`
+}
+
+// newToolOutput returns the structured output with the result text and no optional fields set.
+func newToolOutput(result string) toolOutput {
+	return toolOutput{Result: result, Warnings: nil, Input: nil, Standards: nil, Tags: nil, Error: nil}
+}
+
+// errorCodeNotFound is the code of the typed error returned for a standard that does not exist.
+const errorCodeNotFound = "NOT_FOUND"
+
+// toolError is a typed error of the structured tool output.
+type toolError struct {
+	Code string `json:"code"`
+	// Name is the requested standard name.
+	Name string `json:"name"`
+}
+
+// newNotFoundResult creates a tool result that reports the error as plain text, together with a typed
+// NOT_FOUND error naming the requested standard.
+func newNotFoundResult(name string, err error) *mcp.CallToolResult {
+	result := newErrorResult(err)
+	output := newToolOutput(err.Error())
+	output.Error = &toolError{Code: errorCodeNotFound, Name: name}
+	result.StructuredContent = output
+
+	return result
+}
+
+// listedStandard is a standard of the structured list_standards output.
+type listedStandard struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// listedStandardsOf returns the structured listing of standards, never nil.
+func listedStandardsOf(infos []domain.StandardInfo) []listedStandard {
+	listed := make([]listedStandard, 0, len(infos))
+	for _, info := range infos {
+		listed = append(listed, listedStandard{Name: info.Name, Description: info.Description})
+	}
+
+	return listed
+}
+
+// outputOf returns the structured output of a tool result.
+func outputOf(result *mcp.CallToolResult) toolOutput {
+	if output, ok := result.StructuredContent.(toolOutput); ok {
+		return output
+	}
+
+	return newToolOutput(resultText(result))
+}
+
+// staleWarnings returns a warning for each standard past its review date at the given time.
+func staleWarnings(infos []domain.StandardInfo, now time.Time) []string {
+	var warnings []string
+	for _, info := range infos {
+		if isStale(info, now) {
+			warnings = append(warnings, fmt.Sprintf("standard %s is past its review date %s",
+				info.Name, info.ReviewBy.Format(time.DateOnly)))
+		}
+	}
+
+	return warnings
+}
+
+// notFoundWarnings returns a warning for each requested name missing from the standards.
+// A skipped standard is reported with the reason it was skipped instead of as not found.
+func notFoundWarnings(
+	standardNames []string, standards []domain.Standard, skipped []domain.SkippedStandard,
+) []string {
+	found := make(map[string]struct{}, len(standards))
+	for _, standard := range standards {
+		found[standard.Name] = struct{}{}
+	}
+	reasons := make(map[string]string, len(skipped))
+	for _, standard := range skipped {
+		reasons[standard.Name] = standard.Reason
+	}
+
+	var warnings []string
+	for _, name := range standardNames {
+		if _, ok := found[name]; ok {
+			continue
+		}
+		if reason, ok := reasons[name]; ok {
+			warnings = append(warnings, fmt.Sprintf("standard %s skipped: %s", name, reason))
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("standard %s not found", name))
+	}
+
+	return warnings
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// contentWrapper holds the templates written around the content of each standard.
+// The zero value keeps the built-in heading and code fence.
+type contentWrapper struct {
+	prefix string
+	suffix string
+	// showSize is true when the description is followed by the size of the content.
+	showSize bool
+}
+
+// contentWrapper returns the configured wrapper of standard content.
+func (s *MCP) contentWrapper() contentWrapper {
+	return contentWrapper{prefix: s.cfg.GetContentPrefix(), suffix: s.cfg.GetContentSuffix(), showSize: false}
+}
+
+// isDefault reports whether neither template is configured.
+func (w contentWrapper) isDefault() bool {
+	return w.prefix == "" && w.suffix == ""
+}
+
+// description returns the description of a standard as written in its heading,
+// followed by the line and byte count of the content when the size is shown.
+func (w contentWrapper) description(standard domain.Standard) string {
+	if !w.showSize {
+		return standard.Description
+	}
+
+	return fmt.Sprintf("%s (%d lines, %d bytes)", standard.Description, lineCount(standard.Content), len(standard.Content))
+}
+
+// lineCount returns the number of lines of the content. A trailing newline does not start a new line.
+func lineCount(content string) int {
+	if content == "" {
+		return 0
+	}
+
+	return strings.Count(strings.TrimSuffix(content, "\n"), "\n") + 1
+}
+
+// wrap writes the templates on the lines before and after the content, with {name} and {description}
+// replaced by the standard name and description. Empty templates are omitted.
+func (w contentWrapper) wrap(standard domain.Standard) string {
+	replacer := strings.NewReplacer("{name}", standard.Name, "{description}", w.description(standard))
+
+	var parts []string
+	if w.prefix != "" {
+		parts = append(parts, replacer.Replace(w.prefix))
+	}
+	parts = append(parts, standard.Content)
+	if w.suffix != "" {
+		parts = append(parts, replacer.Replace(w.suffix))
+	}
+
+	return strings.Join(parts, "\n")
+}
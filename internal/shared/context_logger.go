@@ -0,0 +1,27 @@
+package shared //nolint:revive,nolintlint // i like this name :)
+
+import "context"
+
+// loggerKey is the context key of the request Logger.
+type loggerKey struct{}
+
+// nopLogger discards all log records.
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+// WithLogger returns a context carrying the Logger of the request, so that lower layers can log in its scope.
+func WithLogger(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// LoggerFrom returns the Logger of the context, or a Logger discarding all records if there is none.
+func LoggerFrom(ctx context.Context) Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(Logger); ok && logger != nil {
+		return logger
+	}
+	return nopLogger{}
+}
@@ -0,0 +1,17 @@
+package shared //nolint:revive,nolintlint // i like this name :)
+
+import "context"
+
+// frontmatterKey is the context key of the frontmatter flag.
+type frontmatterKey struct{}
+
+// WithFrontmatter returns a context requesting standard content preceded by its frontmatter block.
+func WithFrontmatter(ctx context.Context) context.Context {
+	return context.WithValue(ctx, frontmatterKey{}, true)
+}
+
+// IsFrontmatterIncluded reports whether the context requests standard content preceded by its frontmatter block.
+func IsFrontmatterIncluded(ctx context.Context) bool {
+	included, _ := ctx.Value(frontmatterKey{}).(bool)
+	return included
+}
@@ -0,0 +1,48 @@
+package shared
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Slugify converts a text, e.g. a title, to a lowercase, hyphen-separated name.
+// Texts without letters or digits return an empty string.
+func Slugify(text string) string {
+	var builder strings.Builder
+
+	pendingHyphen := false
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingHyphen && builder.Len() > 0 {
+				builder.WriteByte('-')
+			}
+			pendingHyphen = false
+			builder.WriteRune(r)
+			continue
+		}
+		pendingHyphen = true
+	}
+
+	return builder.String()
+}
+
+// DemoteHeadings turns top-level "#" headings of markdown content into "##" headings, so that they nest under
+// the "## name" heading the content is embedded into. Lines in fenced code blocks are left as is.
+func DemoteHeadings(content string) string {
+	lines := strings.Split(content, "\n")
+
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+
+		if !inFence && (line == "#" || strings.HasPrefix(line, "# ")) {
+			lines[i] = "#" + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
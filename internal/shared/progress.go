@@ -0,0 +1,21 @@
+package shared //nolint:revive,nolintlint // i like this name :)
+
+import "context"
+
+// ProgressFunc receives the number of processed items out of the total.
+type ProgressFunc func(done, total int)
+
+// progressKey is the context key of the ProgressFunc.
+type progressKey struct{}
+
+// WithProgress returns a context carrying a ProgressFunc for long operations.
+func WithProgress(ctx context.Context, progress ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, progress)
+}
+
+// ReportProgress reports the progress of a long operation to the ProgressFunc of the context, if any.
+func ReportProgress(ctx context.Context, done, total int) {
+	if progress, ok := ctx.Value(progressKey{}).(ProgressFunc); ok && progress != nil {
+		progress(done, total)
+	}
+}
@@ -0,0 +1,17 @@
+package shared //nolint:revive,nolintlint // i like this name :)
+
+import "context"
+
+// rawContentKey is the context key of the raw content flag.
+type rawContentKey struct{}
+
+// WithRawContent returns a context requesting standard content as authored, without post-processing.
+func WithRawContent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rawContentKey{}, true)
+}
+
+// IsRawContent reports whether the context requests standard content as authored.
+func IsRawContent(ctx context.Context) bool {
+	raw, _ := ctx.Value(rawContentKey{}).(bool)
+	return raw
+}
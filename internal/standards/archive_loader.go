@@ -0,0 +1,491 @@
+package standards
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+	"github.com/n-r-w/agent-standards-mcp/internal/shared"
+)
+
+// archiveEntry is a standard file read from an archive.
+type archiveEntry struct {
+	// path is the slash-separated path of the entry inside the archive.
+	path    string
+	content []byte
+	// oversized is true for an entry skipped outside strict mode because it exceeds the maximum standard size.
+	// Its content is not read.
+	oversized bool
+}
+
+// ArchiveStandardLoader implements the StandardLoader interface for loading standards from a zip or tar archive.
+// The archive is read on every call, so that a replaced archive is picked up without a restart.
+type ArchiveStandardLoader struct {
+	archivePath  string
+	recursive    bool
+	nameStrategy config.NameStrategy
+	transform    contentTransform
+	// strict is false when oversized standards are skipped instead of failing the request.
+	strict bool
+}
+
+// NewArchiveStandardLoader creates a new ArchiveStandardLoader instance loading the standards
+// from the archive at the source path, see config.Config.GetSourcePath.
+func NewArchiveStandardLoader(archivePath string) (*ArchiveStandardLoader, error) {
+	if archivePath == "" {
+		return nil, errors.New("AGENT_STANDARDS_MCP_SOURCE_PATH is required for the archive source")
+	}
+
+	if !config.IsSupportedArchive(archivePath) {
+		return nil, fmt.Errorf("unsupported archive format: %s", archivePath)
+	}
+
+	return &ArchiveStandardLoader{
+		archivePath:  archivePath,
+		recursive:    getRecursiveScan(),
+		nameStrategy: getNameStrategy(),
+		transform:    getContentTransform(),
+		strict:       getStrictMode(),
+	}, nil
+}
+
+// ListStandards returns a list of available standard information (name and description).
+func (l *ArchiveStandardLoader) ListStandards(ctx context.Context) ([]domain.StandardInfo, error) {
+	entries, err := l.readEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	standardInfos := make([]domain.StandardInfo, 0, len(entries))
+
+	for i, entry := range entries {
+		// Stop early if the request was cancelled
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("standards loading cancelled: %w", err)
+		}
+		if entry.oversized {
+			shared.ReportProgress(ctx, i+1, len(entries))
+			continue
+		}
+
+		fm, _, err := parseFrontmatterData(string(entry.content))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse frontmatter for %s: %w", entry.path, err)
+		}
+
+		_, language := splitLanguage(entry.path)
+
+		standardInfos = append(standardInfos, domain.StandardInfo{
+			Name:         deriveStandardName(l.nameStrategy, entry.path, fm),
+			Description:  fm.description,
+			Path:         entry.path,
+			Language:     language,
+			Languages:    nil,
+			ReviewBy:     fm.reviewByDate,
+			Disabled:     fm.disabled(),
+			Tags:         fm.Tags,
+			When:         fm.When,
+			Order:        fm.Order,
+			Descriptions: fm.descriptions,
+		})
+		shared.ReportProgress(ctx, i+1, len(entries))
+	}
+
+	return standardInfos, nil
+}
+
+// GetStandard returns the full content of a single standard by its name.
+// Of several language variants, the untagged one is returned. domain.ErrStandardNotFound is returned for unknown names.
+func (l *ArchiveStandardLoader) GetStandard(ctx context.Context, standardName string) (domain.Standard, error) {
+	index, err := l.readStandardIndex(ctx)
+	if err != nil {
+		return domain.Standard{}, err
+	}
+
+	variants, err := l.getStandardVariants(ctx, standardName, index)
+	if err != nil {
+		return domain.Standard{}, err
+	}
+
+	return primaryVariant(variants), nil
+}
+
+// GetStandards returns the full content of specific standards by their names.
+// Names missing from the archive are left out. Standards skipped outside strict mode because they are oversized
+// are returned separately.
+func (l *ArchiveStandardLoader) GetStandards(
+	ctx context.Context, standardNames []string,
+) ([]domain.Standard, []domain.SkippedStandard, error) {
+	standards := make([]domain.Standard, 0, len(standardNames))
+	if len(standardNames) == 0 {
+		return standards, nil, nil
+	}
+
+	// The archive is read once for the whole batch
+	index, err := l.readStandardIndex(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var skipped []domain.SkippedStandard
+	for _, standardName := range standardNames {
+		variants, err := l.getStandardVariants(ctx, standardName, index)
+		if errors.Is(err, domain.ErrStandardNotFound) {
+			continue
+		}
+		if errors.Is(err, domain.ErrStandardSkipped) {
+			skipped = append(skipped, skippedStandard(standardName))
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		standards = append(standards, variants...)
+	}
+
+	return standards, skipped, nil
+}
+
+// getStandardVariants parses all language variants of a standard from the indexed archive entries.
+func (l *ArchiveStandardLoader) getStandardVariants(
+	ctx context.Context, standardName string, index map[string][]archiveEntry,
+) ([]domain.Standard, error) {
+	// Stop early if the request was cancelled
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("standards loading cancelled: %w", err)
+	}
+
+	entries := index[standardName]
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrStandardNotFound, standardName)
+	}
+
+	variants := make([]domain.Standard, 0, len(entries))
+	for _, entry := range entries {
+		if entry.oversized {
+			continue
+		}
+
+		parsed, err := parseStandard(string(entry.content))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse frontmatter for standard %s: %w", standardName, err)
+		}
+		fm := parsed.fm
+
+		_, language := splitLanguage(entry.path)
+
+		variants = append(variants, domain.Standard{
+			Name:         standardName,
+			Description:  fm.description,
+			Content:      l.transform.serve(ctx, parsed),
+			Language:     language,
+			Priority:     fm.Priority,
+			Disabled:     fm.disabled(),
+			Title:        fm.Title,
+			Tags:         fm.Tags,
+			When:         fm.When,
+			ReviewBy:     fm.reviewByDate,
+			Descriptions: fm.descriptions,
+		})
+	}
+
+	if len(variants) == 0 {
+		return nil, newSkippedError(standardName)
+	}
+
+	return variants, nil
+}
+
+// readStandardIndex reads the archive and indexes its entries by standard name.
+func (l *ArchiveStandardLoader) readStandardIndex(ctx context.Context) (map[string][]archiveEntry, error) {
+	entries, err := l.readEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := l.buildStandardIndex(entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to index archive entries: %w", err)
+	}
+
+	return index, nil
+}
+
+// buildStandardIndex maps standard names derived by the configured name strategy to the entries
+// of their language variants. If several entries map to the same name and language, the first one wins.
+func (l *ArchiveStandardLoader) buildStandardIndex(entries []archiveEntry) (map[string][]archiveEntry, error) {
+	index := make(map[string][]archiveEntry, len(entries))
+	seen := make(map[string]struct{}, len(entries))
+
+	for _, entry := range entries {
+		var fm frontmatterData
+
+		// Only the title strategy needs the entry content to derive a name,
+		// the name of an oversized entry falls back to its file name
+		if l.nameStrategy == config.NameStrategyTitleSlug && !entry.oversized {
+			var err error
+			if fm, _, err = parseFrontmatterData(string(entry.content)); err != nil {
+				return nil, fmt.Errorf("failed to parse frontmatter for %s: %w", entry.path, err)
+			}
+		}
+
+		name := deriveStandardName(l.nameStrategy, entry.path, fm)
+		_, language := splitLanguage(entry.path)
+
+		key := name + "\x00" + language
+		if _, exists := seen[key]; exists {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		index[name] = append(index[name], entry)
+	}
+
+	return index, nil
+}
+
+// archiveLimits enforces the count and size limits while the entries of an archive are read,
+// so that an archive exceeding them is rejected before the rest of its entries is loaded.
+type archiveLimits struct {
+	maxSize      int64
+	maxStandards int
+	// count is the number of entries admitted so far, total the size of their content read so far.
+	count int
+	total int64
+}
+
+// newArchiveLimits returns the configured limits of the standards read from an archive.
+func newArchiveLimits() (*archiveLimits, error) {
+	maxSize, err := getMaxStandardSize()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get max standard size: %w", err)
+	}
+
+	maxStandards, err := getMaxStandards()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get max standards: %w", err)
+	}
+
+	return &archiveLimits{maxSize: maxSize, maxStandards: maxStandards, count: 0, total: 0}, nil
+}
+
+// admit accounts for an entry of the declared size before its content is read.
+// An oversized entry is not counted. The total size of the entries is limited to maxStandards entries
+// of the maximum size.
+func (a *archiveLimits) admit(entryPath string, size int64) error {
+	if size > a.maxSize {
+		return &FileTooLargeError{Path: entryPath, Size: size, Limit: a.maxSize}
+	}
+	if a.count >= a.maxStandards {
+		return fmt.Errorf("%w of %d: the archive holds more", domain.ErrTooManyStandards, a.maxStandards)
+	}
+	if maxTotal := a.maxSize * int64(a.maxStandards); a.total+size > maxTotal {
+		return fmt.Errorf("archive entries exceed %d bytes in total", maxTotal)
+	}
+
+	a.count++
+	return nil
+}
+
+// read accounts for the content read from an admitted entry.
+func (a *archiveLimits) read(content []byte) {
+	a.total += int64(len(content))
+}
+
+// readEntries reads the standard files of the archive and validates them against the count and size limits.
+// Sizes are checked against the uncompressed entry sizes.
+// Outside strict mode oversized entries are skipped with a warning.
+func (l *ArchiveStandardLoader) readEntries(ctx context.Context) ([]archiveEntry, error) {
+	limits, err := newArchiveLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []archiveEntry
+	if strings.HasSuffix(strings.ToLower(l.archivePath), ".zip") {
+		entries, err = l.readZipEntries(ctx, limits)
+	} else {
+		entries, err = l.readTarEntries(ctx, limits)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive %s: %w", l.archivePath, err)
+	}
+
+	return entries, nil
+}
+
+// readZipEntries reads the standard files of a zip archive.
+func (l *ArchiveStandardLoader) readZipEntries(ctx context.Context, limits *archiveLimits) ([]archiveEntry, error) {
+	reader, err := zip.OpenReader(filepath.Clean(l.archivePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open zip archive: %w", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	var entries []archiveEntry
+	for _, file := range reader.File {
+		entryPath, ok, err := l.standardEntryPath(file.Name, file.Mode())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		entry, err := l.readEntry(ctx, limits, entryPath, file.FileInfo().Size(), func() ([]byte, error) {
+			return readZipFile(file, entryPath, limits.maxSize)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// readEntry reads an archive entry of the declared size with read, within the limits.
+// An oversized entry skipped outside strict mode is returned without content.
+func (l *ArchiveStandardLoader) readEntry(
+	ctx context.Context, limits *archiveLimits, entryPath string, size int64, read func() ([]byte, error),
+) (archiveEntry, error) {
+	err := limits.admit(entryPath, size)
+	var content []byte
+	if err == nil {
+		if content, err = read(); err != nil {
+			err = fmt.Errorf("failed to read %s: %w", entryPath, err)
+		}
+	}
+
+	if errors.Is(err, domain.ErrFileTooLarge) && !l.strict {
+		shared.LoggerFrom(ctx).Warn("Skipping oversized standard", "path", entryPath, "error", err)
+		return archiveEntry{path: entryPath, content: nil, oversized: true}, nil
+	}
+	if err != nil {
+		return archiveEntry{}, err
+	}
+
+	limits.read(content)
+	return archiveEntry{path: entryPath, content: content, oversized: false}, nil
+}
+
+// readZipFile reads a single zip entry of at most maxSize bytes.
+func readZipFile(file *zip.File, entryPath string, maxSize int64) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open entry: %w", err)
+	}
+	defer func() { _ = rc.Close() }()
+
+	return readLimited(rc, entryPath, maxSize)
+}
+
+// readTarEntries reads the standard files of a tar archive, optionally gzip-compressed.
+func (l *ArchiveStandardLoader) readTarEntries(ctx context.Context, limits *archiveLimits) ([]archiveEntry, error) {
+	file, err := os.Open(filepath.Clean(l.archivePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tar archive: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var source io.Reader = file
+	lowerPath := strings.ToLower(l.archivePath)
+	if strings.HasSuffix(lowerPath, ".tar.gz") || strings.HasSuffix(lowerPath, ".tgz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress tar archive: %w", err)
+		}
+		defer func() { _ = gz.Close() }()
+		source = gz
+	}
+
+	var entries []archiveEntry
+	reader := tar.NewReader(source)
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tar archive: %w", err)
+		}
+
+		entryPath, ok, err := l.standardEntryPath(header.Name, header.FileInfo().Mode())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		entry, err := l.readEntry(ctx, limits, entryPath, header.Size, func() ([]byte, error) {
+			return readLimited(reader, entryPath, limits.maxSize)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// standardEntryPath returns the cleaned path of an archive entry and reports whether it is a standard file,
+// i.e. a visible regular markdown file inside the scanned part of the archive.
+// Entries leaving the archive root are rejected to prevent traversal.
+func (l *ArchiveStandardLoader) standardEntryPath(name string, mode os.FileMode) (string, bool, error) {
+	if !mode.IsRegular() {
+		return "", false, nil
+	}
+
+	entryPath := path.Clean(strings.ReplaceAll(name, "\\", "/"))
+	if path.IsAbs(entryPath) || entryPath == ".." || strings.HasPrefix(entryPath, "../") {
+		return "", false, fmt.Errorf("%w: %s", domain.ErrPathTraversal, name)
+	}
+
+	// Skip hidden files and files in hidden directories
+	for _, segment := range strings.Split(entryPath, "/") {
+		if strings.HasPrefix(segment, ".") {
+			return "", false, nil
+		}
+	}
+
+	if path.Ext(entryPath) != ".md" {
+		return "", false, nil
+	}
+
+	// Nested entries are only scanned recursively
+	if !l.recursive && path.Dir(entryPath) != "." {
+		return "", false, nil
+	}
+
+	return entryPath, true, nil
+}
+
+// readLimited reads at most maxSize bytes and fails if the reader holds more,
+// as the sizes recorded in archive headers are not trusted.
+func readLimited(r io.Reader, entryPath string, maxSize int64) ([]byte, error) {
+	// Read one byte past the limit to detect oversized entries
+	data, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read entry: %w", err)
+	}
+
+	if int64(len(data)) > maxSize {
+		return nil, &FileTooLargeError{Path: entryPath, Size: -1, Limit: maxSize}
+	}
+
+	return data, nil
+}
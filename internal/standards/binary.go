@@ -0,0 +1,34 @@
+package standards
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/shared"
+)
+
+// binarySniffSize is the number of leading bytes inspected to tell binary files from text files.
+const binarySniffSize = 8000
+
+// isBinaryContent reports whether content looks binary, i.e. has a NUL byte in its first chunk.
+// Markdown never contains NUL bytes, while almost all binary formats do.
+func isBinaryContent(content []byte) bool {
+	if len(content) > binarySniffSize {
+		content = content[:binarySniffSize]
+	}
+
+	return bytes.IndexByte(content, 0) >= 0
+}
+
+// isBinaryStandard reports whether the content read from a standard file looks binary, logging a warning
+// for a binary file, so that a misplaced binary file never ends up in a response.
+// The content is checked once read, encrypted content once decrypted.
+func (l *FileStandardLoader) isBinaryStandard(ctx context.Context, filePath string, content []byte) bool {
+	if !isBinaryContent(content) {
+		return false
+	}
+
+	shared.LoggerFrom(ctx).Warn("Skipping binary file", "file_path", l.relativePath(filePath))
+
+	return true
+}
@@ -0,0 +1,74 @@
+package standards
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+const (
+	// encryptedExt is the extension of encrypted standard files, following the markdown extension, e.g. "go.md.enc".
+	encryptedExt = ".enc"
+	// gcmNonceSize is the size of the random nonce an encrypted standard file starts with.
+	gcmNonceSize = 12
+	// gcmTagSize is the size of the authentication tag an encrypted standard file ends with.
+	gcmTagSize = 16
+	// encryptionOverhead is the number of bytes an encrypted standard file is longer than its decrypted content.
+	encryptionOverhead = gcmNonceSize + gcmTagSize
+)
+
+// isEncryptedPath reports whether a path names an encrypted standard file, e.g. "go.md.enc".
+func isEncryptedPath(filePath string) bool {
+	return strings.HasSuffix(filePath, ".md"+encryptedExt)
+}
+
+// decryptedPath returns the path of the markdown file held by an encrypted standard file,
+// e.g. "go.md" for "go.md.enc". Other paths are returned unchanged.
+func decryptedPath(filePath string) string {
+	if !isEncryptedPath(filePath) {
+		return filePath
+	}
+
+	return strings.TrimSuffix(filePath, encryptedExt)
+}
+
+// decryptStandard decrypts the content of an encrypted standard file with the AES key of
+// AGENT_STANDARDS_MCP_DECRYPT_KEY. The file holds a 12-byte nonce followed by the AES-GCM sealed content,
+// so that corrupted or tampered files, as well as a wrong key, fail authentication.
+func decryptStandard(filePath string, content []byte) ([]byte, error) {
+	value := os.Getenv("AGENT_STANDARDS_MCP_DECRYPT_KEY")
+	if value == "" {
+		return nil, fmt.Errorf("%w: %s", domain.ErrDecryptKeyMissing, filePath)
+	}
+
+	key, err := config.ParseDecryptKey(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AGENT_STANDARDS_MCP_DECRYPT_KEY value: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AGENT_STANDARDS_MCP_DECRYPT_KEY value: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES-GCM cipher: %w", err)
+	}
+
+	if len(content) < encryptionOverhead {
+		return nil, fmt.Errorf("%w: %s is too short to be encrypted", domain.ErrDecryptionFailed, filePath)
+	}
+
+	plaintext, err := gcm.Open(nil, content[:gcmNonceSize], content[gcmNonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: wrong key or corrupted file", domain.ErrDecryptionFailed, filePath)
+	}
+
+	return plaintext, nil
+}
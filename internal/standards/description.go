@@ -0,0 +1,86 @@
+package standards
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// i18nText is a frontmatter value given either as a single string or as a map of two-letter
+// language tags to strings, e.g. `description: {en: "Error handling", ru: "Обработка ошибок"}`.
+type i18nText struct {
+	text         string
+	translations map[string]string
+}
+
+// UnmarshalYAML decodes a scalar or a map of language tags to strings.
+func (t *i18nText) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&t.text)
+	}
+
+	if node.Kind != yaml.MappingNode {
+		return &yaml.TypeError{Errors: []string{fmt.Sprintf(
+			"line %d: cannot unmarshal %s into a string or a map of languages to strings", node.Line, node.ShortTag())}}
+	}
+
+	var translations map[string]string
+	if err := node.Decode(&translations); err != nil {
+		return err
+	}
+
+	t.translations = make(map[string]string, len(translations))
+	for language, text := range translations {
+		language = strings.ToLower(strings.TrimSpace(language))
+		if !config.IsLanguageTag(language) {
+			return fmt.Errorf("line %d: invalid language %q (must be a two-letter ISO 639-1 language tag)",
+				node.Line, language)
+		}
+		t.translations[language] = strings.TrimSpace(text)
+	}
+
+	return nil
+}
+
+// resolve returns the text for the default language and all translations, nil for a scalar value.
+// A map without the default language falls back to the first language in alphabetical order.
+func (t i18nText) resolve(defaultLanguage string) (string, map[string]string) {
+	if t.translations == nil {
+		return strings.TrimSpace(t.text), nil
+	}
+
+	if text, ok := t.translations[defaultLanguage]; ok {
+		return text, t.translations
+	}
+
+	languages := make([]string, 0, len(t.translations))
+	for language := range t.translations {
+		languages = append(languages, language)
+	}
+	slices.Sort(languages)
+
+	if len(languages) == 0 {
+		return "", t.translations
+	}
+
+	return t.translations[languages[0]], t.translations
+}
+
+// validate checks that a map value has translations and all of them are set.
+func (t i18nText) validate() error {
+	if t.translations != nil && len(t.translations) == 0 {
+		return errors.New("frontmatter 'description' cannot be an empty map")
+	}
+
+	for language, text := range t.translations {
+		if text == "" {
+			return fmt.Errorf("frontmatter 'description' cannot be empty for language %s", language)
+		}
+	}
+
+	return nil
+}
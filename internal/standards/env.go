@@ -0,0 +1,187 @@
+package standards
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+)
+
+// getRecursiveScan reports whether the standards folder should be scanned recursively.
+func getRecursiveScan() bool {
+	recursive, err := strconv.ParseBool(os.Getenv("AGENT_STANDARDS_MCP_RECURSIVE"))
+	if err != nil {
+		// Default to flat scanning if not set or invalid
+		return false
+	}
+
+	return recursive
+}
+
+// getMaxDepth returns the number of directory levels below the standards folder scanned recursively,
+// 0 for unlimited.
+func getMaxDepth() int {
+	depth, err := strconv.Atoi(os.Getenv("AGENT_STANDARDS_MCP_MAX_DEPTH"))
+	if err != nil || depth < 0 {
+		// Default to 10 levels if not set or invalid
+		return defaultMaxDepth
+	}
+
+	return depth
+}
+
+// getReadRetries returns the number of times a file read failing with a transient error is retried.
+func getReadRetries() int {
+	retries, err := strconv.Atoi(os.Getenv("AGENT_STANDARDS_MCP_READ_RETRIES"))
+	if err != nil || retries < 0 {
+		// Default to a single read if not set or invalid
+		return 0
+	}
+
+	return retries
+}
+
+// getDefaultLanguage returns the language whose description is used when no language is requested.
+func getDefaultLanguage() string {
+	language, ok := os.LookupEnv("AGENT_STANDARDS_MCP_DEFAULT_LANGUAGE")
+	if !ok {
+		// Default to English if not set
+		return "en"
+	}
+
+	return strings.ToLower(language)
+}
+
+// getNameStrategy returns the strategy used to derive standard names from files.
+func getNameStrategy() config.NameStrategy {
+	strategy := config.NameStrategy(strings.ToLower(os.Getenv("AGENT_STANDARDS_MCP_NAME_STRATEGY")))
+	switch strategy {
+	case config.NameStrategyFirstDot, config.NameStrategyTitleSlug:
+		return strategy
+	case config.NameStrategyFilename:
+		return config.NameStrategyFilename
+	default:
+		// Default to file names if not set or invalid
+		return config.NameStrategyFilename
+	}
+}
+
+// getLanguageVariants reports whether an ISO 639-1 tag before the extension of a standard file name,
+// e.g. "errors.ru.md", marks a language variant instead of being part of the standard name.
+func getLanguageVariants() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("AGENT_STANDARDS_MCP_LANGUAGE_VARIANTS"))
+	if err != nil {
+		// Default to keeping every suffix in the name if not set or invalid
+		return false
+	}
+
+	return enabled
+}
+
+// getStrictMode reports whether oversized standards fail a get request instead of being skipped.
+func getStrictMode() bool {
+	strict, err := strconv.ParseBool(os.Getenv("AGENT_STANDARDS_MCP_STRICT"))
+	if err != nil {
+		// Default to failing the request if not set or invalid
+		return true
+	}
+
+	return strict
+}
+
+// getPreserveWhitespace reports whether whitespace surrounding standard content is kept,
+// except for a single leading and trailing newline.
+func getPreserveWhitespace() bool {
+	preserve, err := strconv.ParseBool(os.Getenv("AGENT_STANDARDS_MCP_PRESERVE_WHITESPACE"))
+	if err != nil {
+		// Default to trimming all surrounding whitespace if not set or invalid
+		return false
+	}
+
+	return preserve
+}
+
+// getTitleFromHeading reports whether the first "# " heading of the content is the title of standards
+// without a frontmatter title.
+func getTitleFromHeading() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("AGENT_STANDARDS_MCP_TITLE_FROM_HEADING"))
+	if err != nil {
+		// Default to frontmatter titles only if not set or invalid
+		return false
+	}
+
+	return enabled
+}
+
+// getStripTitleHeading reports whether the heading taken as the title is removed from the content.
+func getStripTitleHeading() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("AGENT_STANDARDS_MCP_STRIP_TITLE_HEADING"))
+	if err != nil {
+		// Default to keeping the heading in the content if not set or invalid
+		return false
+	}
+
+	return enabled
+}
+
+// getEmptyDescriptionOK reports whether an empty or whitespace-only frontmatter description is treated
+// as no description instead of an error.
+func getEmptyDescriptionOK() bool {
+	ok, err := strconv.ParseBool(os.Getenv("AGENT_STANDARDS_MCP_EMPTY_DESCRIPTION_OK"))
+	if err != nil {
+		// Default to failing the standard if not set or invalid
+		return false
+	}
+
+	return ok
+}
+
+// getCacheTTL returns how long a scan of the standards folder is reused, 0 to scan on every request.
+func getCacheTTL() time.Duration {
+	ttl, err := time.ParseDuration(os.Getenv("AGENT_STANDARDS_MCP_CACHE_TTL"))
+	if err != nil || ttl < 0 {
+		// Default to scanning on every request if not set or invalid
+		return 0
+	}
+
+	return ttl
+}
+
+// getSharedScanCache reports whether scans of the standards folder are cached for all loaders of the process.
+func getSharedScanCache() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("AGENT_STANDARDS_MCP_SHARED_SCAN_CACHE"))
+	if err != nil {
+		// Default to a cache per loader if not set or invalid
+		return false
+	}
+
+	return enabled
+}
+
+// getContentTransform returns the post-processing steps applied to standard content.
+func getContentTransform() contentTransform {
+	normalizeNewlines, err := strconv.ParseBool(os.Getenv("AGENT_STANDARDS_MCP_NORMALIZE_NEWLINES"))
+	if err != nil {
+		// Default to LF line endings if not set or invalid
+		normalizeNewlines = true
+	}
+
+	stripComments, err := strconv.ParseBool(os.Getenv("AGENT_STANDARDS_MCP_STRIP_HTML_COMMENTS"))
+	if err != nil {
+		// Default to the content as authored if not set or invalid
+		stripComments = false
+	}
+
+	demoteHeadings, err := strconv.ParseBool(os.Getenv("AGENT_STANDARDS_MCP_DEMOTE_HEADINGS"))
+	if err != nil {
+		demoteHeadings = false
+	}
+
+	return contentTransform{
+		normalizeNewlines: normalizeNewlines,
+		stripComments:     stripComments,
+		demoteHeadings:    demoteHeadings,
+	}
+}
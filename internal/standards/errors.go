@@ -0,0 +1,62 @@
+package standards
+
+import (
+	"fmt"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+)
+
+// FileTooLargeError is returned when a standard file exceeds the maximum standard size.
+// It wraps domain.ErrFileTooLarge and reports both sizes human-readably along with the raw byte counts.
+type FileTooLargeError struct {
+	Path string
+	// Size is negative when the content was streamed without a declared size and only the overflow is known.
+	Size  int64
+	Limit int64
+}
+
+// Error returns the message of the error, e.g.
+// "file size exceeds maximum limit of 10.0 KB (10240 bytes): /standards/go.md is 2.0 MB (2097152 bytes)".
+func (e *FileTooLargeError) Error() string {
+	if e.Size < 0 {
+		return fmt.Sprintf("%s of %s (%d bytes): %s",
+			domain.ErrFileTooLarge, formatSize(e.Limit), e.Limit, e.Path)
+	}
+
+	return fmt.Sprintf("%s of %s (%d bytes): %s is %s (%d bytes)",
+		domain.ErrFileTooLarge, formatSize(e.Limit), e.Limit, e.Path, formatSize(e.Size), e.Size)
+}
+
+// Unwrap returns domain.ErrFileTooLarge, so that errors.Is matches the error.
+func (e *FileTooLargeError) Unwrap() error {
+	return domain.ErrFileTooLarge
+}
+
+// newSkippedError returns the error of a requested standard skipped outside strict mode
+// because it exceeds the maximum standard size. It wraps domain.ErrStandardSkipped.
+func newSkippedError(standardName string) error {
+	return fmt.Errorf("standard %s skipped: %w", standardName, domain.ErrStandardSkipped)
+}
+
+// skippedStandard returns the skipped standard reported for a name whose standard is skipped.
+func skippedStandard(standardName string) domain.SkippedStandard {
+	return domain.SkippedStandard{Name: standardName, Reason: domain.ErrStandardSkipped.Error()}
+}
+
+// formatSize formats a size in bytes with the largest fitting unit of 1024, e.g. "512 B" or "2.0 MB".
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	value := float64(size) / unit
+	units := []string{"KB", "MB", "GB", "TB"}
+	i := 0
+	for value >= unit && i < len(units)-1 {
+		value /= unit
+		i++
+	}
+
+	return fmt.Sprintf("%.1f %s", value, units[i])
+}
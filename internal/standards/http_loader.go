@@ -0,0 +1,392 @@
+package standards
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/condition"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+	"github.com/n-r-w/agent-standards-mcp/internal/shared"
+)
+
+const (
+	// httpIndexFile is the name of the index document relative to the source URL
+	httpIndexFile = "index.json"
+	// defaultHTTPTimeout is the timeout of a single request to the standards server
+	defaultHTTPTimeout = 10 * time.Second
+	// defaultHTTPCacheTTL is how long fetched documents are served from the cache
+	defaultHTTPCacheTTL = time.Minute
+	// maxHTTPIndexSize is the maximum size of the index document in bytes
+	maxHTTPIndexSize = 4 * oneMB
+)
+
+// httpIndex is the index document listing the standards served over HTTP.
+type httpIndex struct {
+	Standards []httpIndexEntry `json:"standards"`
+}
+
+// httpIndexEntry describes a single standard in the index document.
+// The index is the source of the metadata it lists, both for listing and for fetching a standard, so that
+// the two agree even if the frontmatter of the fetched file differs. The frontmatter provides the rest.
+type httpIndexEntry struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Path is the location of the standard file, relative to the source URL.
+	Path     string `json:"path"`
+	Language string `json:"language"`
+	ReviewBy string `json:"review_by"`
+	// Enabled is false for a staged standard, absent means enabled.
+	Enabled *bool    `json:"enabled"`
+	Tags    []string `json:"tags"`
+	// When is the condition on the request context under which the standard applies, absent if it always does.
+	When string `json:"when"`
+	// Order is the position of the standard in listings sorted by order, absent to list it last.
+	Order *int `json:"order"`
+}
+
+// httpCacheEntry is a fetched document with the time it was fetched at.
+type httpCacheEntry struct {
+	data      []byte
+	fetchedAt time.Time
+}
+
+// HTTPStandardLoader implements the StandardLoader interface for loading standards from an HTTP server.
+// The server must provide an index.json document listing the standards and their file paths.
+type HTTPStandardLoader struct {
+	baseURL   *url.URL
+	client    *http.Client
+	cacheTTL  time.Duration
+	transform contentTransform
+	// strict is false when oversized standards are skipped instead of failing the request.
+	strict bool
+
+	mu    sync.Mutex
+	cache map[string]httpCacheEntry
+	// hits and misses count the documents served from the cache and fetched from the server.
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// NewHTTPStandardLoader creates a new HTTPStandardLoader instance loading the standards listed
+// in the index.json document at the source URL, see config.Config.GetSourceURL.
+func NewHTTPStandardLoader(sourceURL string) (*HTTPStandardLoader, error) {
+	if sourceURL == "" {
+		return nil, errors.New("AGENT_STANDARDS_MCP_SOURCE_URL is required for the http source")
+	}
+
+	baseURL, err := url.Parse(sourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AGENT_STANDARDS_MCP_SOURCE_URL value: %w", err)
+	}
+
+	// Relative paths in the index resolve against the source directory
+	if !strings.HasSuffix(baseURL.Path, "/") {
+		baseURL.Path += "/"
+	}
+
+	return &HTTPStandardLoader{
+		baseURL: baseURL,
+		client: &http.Client{
+			Transport:     nil,
+			CheckRedirect: nil,
+			Jar:           nil,
+			Timeout:       defaultHTTPTimeout,
+		},
+		cacheTTL:  defaultHTTPCacheTTL,
+		transform: getContentTransform(),
+		strict:    getStrictMode(),
+		mu:        sync.Mutex{},
+		cache:     make(map[string]httpCacheEntry),
+		hits:      atomic.Int64{},
+		misses:    atomic.Int64{},
+	}, nil
+}
+
+// ListStandards returns a list of available standard information (name and description).
+func (l *HTTPStandardLoader) ListStandards(ctx context.Context) ([]domain.StandardInfo, error) {
+	index, err := l.fetchIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	standardInfos := make([]domain.StandardInfo, 0, len(index.Standards))
+
+	for _, entry := range index.Standards {
+		info, err := entry.standardInfo()
+		if err != nil {
+			return nil, err
+		}
+		standardInfos = append(standardInfos, info)
+	}
+
+	return standardInfos, nil
+}
+
+// standardInfo returns the information of the standard described by the index entry.
+func (e httpIndexEntry) standardInfo() (domain.StandardInfo, error) {
+	reviewBy, err := parseReviewDate(strings.TrimSpace(e.ReviewBy))
+	if err != nil {
+		return domain.StandardInfo{}, fmt.Errorf("invalid index entry %s: %w", e.Name, err)
+	}
+
+	return domain.StandardInfo{
+		Name:         e.Name,
+		Description:  e.Description,
+		Path:         e.Path,
+		Language:     e.Language,
+		Languages:    nil,
+		ReviewBy:     reviewBy,
+		Disabled:     e.Enabled != nil && !*e.Enabled,
+		Tags:         e.Tags,
+		When:         strings.TrimSpace(e.When),
+		Order:        e.Order,
+		Descriptions: nil,
+	}, nil
+}
+
+// GetStandard returns the full content of a single standard by its name.
+// Of several language variants, the untagged one is returned. domain.ErrStandardNotFound is returned for unknown names.
+func (l *HTTPStandardLoader) GetStandard(ctx context.Context, standardName string) (domain.Standard, error) {
+	index, err := l.fetchIndex(ctx)
+	if err != nil {
+		return domain.Standard{}, err
+	}
+
+	variants, err := l.getStandardVariants(ctx, standardName, index)
+	if err != nil {
+		return domain.Standard{}, err
+	}
+
+	return primaryVariant(variants), nil
+}
+
+// GetStandards returns the full content of specific standards by their names.
+// Names missing from the index are left out. Standards skipped outside strict mode because they are oversized
+// are returned separately.
+func (l *HTTPStandardLoader) GetStandards(
+	ctx context.Context, standardNames []string,
+) ([]domain.Standard, []domain.SkippedStandard, error) {
+	standards := make([]domain.Standard, 0, len(standardNames))
+	if len(standardNames) == 0 {
+		return standards, nil, nil
+	}
+
+	index, err := l.fetchIndex(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var skipped []domain.SkippedStandard
+	for _, standardName := range standardNames {
+		variants, err := l.getStandardVariants(ctx, standardName, index)
+		if errors.Is(err, domain.ErrStandardNotFound) {
+			continue
+		}
+		if errors.Is(err, domain.ErrStandardSkipped) {
+			skipped = append(skipped, skippedStandard(standardName))
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		standards = append(standards, variants...)
+	}
+
+	return standards, skipped, nil
+}
+
+// getStandardVariants fetches all language variants of a standard listed in the index.
+func (l *HTTPStandardLoader) getStandardVariants(
+	ctx context.Context, standardName string, index httpIndex,
+) ([]domain.Standard, error) {
+	var variants []domain.Standard
+	skipped := false
+	for _, entry := range index.Standards {
+		if entry.Name != standardName {
+			continue
+		}
+
+		standard, err := l.readStandard(ctx, entry)
+		if errors.Is(err, domain.ErrFileTooLarge) && !l.strict {
+			shared.LoggerFrom(ctx).Warn("Skipping oversized standard",
+				"standard", standardName, "path", entry.Path, "error", err)
+			skipped = true
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, standard)
+	}
+
+	if len(variants) == 0 && skipped {
+		return nil, newSkippedError(standardName)
+	}
+	if len(variants) == 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrStandardNotFound, standardName)
+	}
+
+	return variants, nil
+}
+
+// readStandard fetches and parses a single standard listed in the index.
+// The metadata listed in the index takes precedence over the frontmatter, see httpIndexEntry.
+func (l *HTTPStandardLoader) readStandard(ctx context.Context, entry httpIndexEntry) (domain.Standard, error) {
+	info, err := entry.standardInfo()
+	if err != nil {
+		return domain.Standard{}, err
+	}
+
+	maxSize, err := getMaxStandardSize()
+	if err != nil {
+		return domain.Standard{}, fmt.Errorf("failed to get max standard size: %w", err)
+	}
+
+	content, err := l.fetch(ctx, entry.Path, maxSize)
+	if err != nil {
+		return domain.Standard{}, fmt.Errorf("failed to fetch standard %s: %w", entry.Name, err)
+	}
+
+	parsed, err := parseStandard(string(content))
+	if err != nil {
+		return domain.Standard{}, fmt.Errorf("failed to parse frontmatter for standard %s: %w", entry.Name, err)
+	}
+	fm := parsed.fm
+
+	return domain.Standard{
+		Name:         info.Name,
+		Description:  info.Description,
+		Content:      l.transform.serve(ctx, parsed),
+		Language:     info.Language,
+		Priority:     fm.Priority,
+		Disabled:     info.Disabled,
+		Title:        fm.Title,
+		Tags:         info.Tags,
+		When:         info.When,
+		ReviewBy:     info.ReviewBy,
+		Descriptions: info.Descriptions,
+	}, nil
+}
+
+// fetchIndex fetches and validates the index document.
+func (l *HTTPStandardLoader) fetchIndex(ctx context.Context) (httpIndex, error) {
+	data, err := l.fetch(ctx, httpIndexFile, maxHTTPIndexSize)
+	if err != nil {
+		return httpIndex{}, fmt.Errorf("failed to fetch standards index: %w", err)
+	}
+
+	var index httpIndex
+	if err := json.Unmarshal(data, &index); err != nil {
+		return httpIndex{}, fmt.Errorf("failed to decode standards index: %w", err)
+	}
+
+	maxStandards, err := getMaxStandards()
+	if err != nil {
+		return httpIndex{}, fmt.Errorf("failed to get max standards: %w", err)
+	}
+
+	if len(index.Standards) > maxStandards {
+		return httpIndex{}, fmt.Errorf("%w of %d: %d", domain.ErrTooManyStandards, maxStandards, len(index.Standards))
+	}
+
+	for _, entry := range index.Standards {
+		if entry.Name == "" || entry.Path == "" {
+			return httpIndex{}, errors.New("standards index entries must have a name and a path")
+		}
+		if when := strings.TrimSpace(entry.When); when != "" {
+			if _, err := condition.Parse(when); err != nil {
+				return httpIndex{}, fmt.Errorf("invalid index entry %s: invalid 'when': %w", entry.Name, err)
+			}
+		}
+	}
+
+	return index, nil
+}
+
+// fetch returns the document at the path relative to the source URL, served from the cache while it is fresh.
+// Documents larger than maxSize bytes are rejected.
+func (l *HTTPStandardLoader) fetch(ctx context.Context, path string, maxSize int64) ([]byte, error) {
+	target, err := l.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	cached, ok := l.cache[target]
+	l.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < l.cacheTTL {
+		l.hits.Add(1)
+		return cached.data, nil
+	}
+	l.misses.Add(1)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for %s: %w", target, err)
+	}
+
+	response, err := l.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request %s: %w", target, err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status for %s: %s", target, response.Status)
+	}
+
+	if response.ContentLength > maxSize {
+		return nil, &FileTooLargeError{Path: target, Size: response.ContentLength, Limit: maxSize}
+	}
+
+	// Read one byte past the limit to detect oversized documents without a declared length
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", target, err)
+	}
+
+	if int64(len(data)) > maxSize {
+		return nil, &FileTooLargeError{Path: target, Size: -1, Limit: maxSize}
+	}
+
+	l.mu.Lock()
+	l.cache[target] = httpCacheEntry{data: data, fetchedAt: time.Now()}
+	l.mu.Unlock()
+
+	return data, nil
+}
+
+// CacheStats returns the statistics of the document cache accumulated since the loader was created.
+func (l *HTTPStandardLoader) CacheStats() domain.CacheStats {
+	l.mu.Lock()
+	entries := len(l.cache)
+	l.mu.Unlock()
+
+	return domain.CacheStats{Hits: l.hits.Load(), Misses: l.misses.Load(), Entries: entries}
+}
+
+// resolve returns the absolute URL of a path relative to the source URL.
+// Paths leaving the source URL are rejected to prevent traversal to other resources.
+func (l *HTTPStandardLoader) resolve(path string) (string, error) {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid standard path %s: %w", path, err)
+	}
+
+	target := l.baseURL.ResolveReference(ref)
+	if target.Scheme != l.baseURL.Scheme || target.Host != l.baseURL.Host ||
+		!strings.HasPrefix(target.Path, l.baseURL.Path) {
+		return "", fmt.Errorf("%w: %s", domain.ErrPathTraversal, path)
+	}
+
+	return target.String(), nil
+}
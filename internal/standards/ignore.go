@@ -0,0 +1,114 @@
+package standards
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ignoreFileName is the name of the file in the standards directory listing patterns of files to skip.
+const ignoreFileName = ".standardsignore"
+
+// ignorePattern is a single glob pattern of an ignore file.
+type ignorePattern struct {
+	glob string
+	// anchored patterns contain a slash and match the path relative to the standards directory,
+	// other patterns match the name of a file or directory at any depth.
+	anchored bool
+	// dirOnly patterns end with a slash and only match directories.
+	dirOnly bool
+}
+
+// ignoreRules holds the patterns of an ignore file. The zero value ignores nothing.
+type ignoreRules struct {
+	patterns []ignorePattern
+}
+
+// loadIgnoreRules reads the ignore file of the standards directory. A missing file ignores nothing.
+func loadIgnoreRules(standardsDir string) (ignoreRules, error) {
+	content, err := os.ReadFile(filepath.Join(standardsDir, ignoreFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return ignoreRules{patterns: nil}, nil
+	}
+	if err != nil {
+		return ignoreRules{}, fmt.Errorf("failed to read %s: %w", ignoreFileName, err)
+	}
+
+	return parseIgnoreRules(content)
+}
+
+// parseIgnoreRules parses glob patterns, one per line. Blank lines and lines starting with # are skipped.
+func parseIgnoreRules(content []byte) (ignoreRules, error) {
+	var rules ignoreRules
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		pattern := ignorePattern{glob: line, anchored: false, dirOnly: false}
+		if strings.HasSuffix(pattern.glob, "/") {
+			pattern.dirOnly = true
+			pattern.glob = strings.TrimSuffix(pattern.glob, "/")
+		}
+		if strings.Contains(pattern.glob, "/") {
+			pattern.anchored = true
+			pattern.glob = strings.TrimPrefix(pattern.glob, "/")
+		}
+
+		// Reject malformed patterns early instead of silently matching nothing
+		if _, err := path.Match(pattern.glob, ""); err != nil {
+			return ignoreRules{}, fmt.Errorf("invalid pattern %q in %s: %w", line, ignoreFileName, err)
+		}
+
+		rules.patterns = append(rules.patterns, pattern)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return ignoreRules{}, fmt.Errorf("failed to parse %s: %w", ignoreFileName, err)
+	}
+
+	return rules, nil
+}
+
+// matches reports whether a slash-separated path relative to the standards directory is ignored.
+func (r ignoreRules) matches(relPath string, isDir bool) bool {
+	for _, pattern := range r.patterns {
+		if pattern.dirOnly && !isDir {
+			continue
+		}
+
+		target := path.Base(relPath)
+		if pattern.anchored {
+			target = relPath
+		}
+
+		if matched, _ := path.Match(pattern.glob, target); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// excludes reports whether a file is ignored by itself or through one of its parent directories.
+func (r ignoreRules) excludes(relPath string) bool {
+	if r.matches(relPath, false) {
+		return true
+	}
+
+	for dir := path.Dir(relPath); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if r.matches(dir, true) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,121 +4,415 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
+	"time"
 
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
 	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+	"github.com/n-r-w/agent-standards-mcp/internal/shared"
 )
 
 // FileStandardLoader implements the StandardLoader interface for loading standards from the file system.
 type FileStandardLoader struct {
 	standardsDir string
+	recursive    bool
+	// maxDepth is the number of directory levels below the standards directory scanned recursively, 0 for unlimited.
+	maxDepth     int
+	nameStrategy config.NameStrategy
+	transform    contentTransform
+	reader       retryingReader
+	// strict is false when oversized standards are skipped instead of failing the request.
+	strict bool
+	// folderSeen is set once the standards folder has been read successfully.
+	folderSeen atomic.Bool
+	// cacheTTL is how long a scan of the standards folder is reused, 0 to scan on every request.
+	cacheTTL time.Duration
+	// scans caches the scans of the standards folder, shared by all loaders of the process when enabled.
+	scans *scanCache
+	// scanKey identifies the scans of this loader in scans.
+	scanKey string
 }
 
-// NewFileStandardLoader creates a new FileStandardLoader instance.
-func NewFileStandardLoader() *FileStandardLoader {
-	standardsDir := os.Getenv("AGENT_STANDARDS_MCP_FOLDER")
-	if standardsDir == "" {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			homeDir = "."
-		}
-		standardsDir = filepath.Join(homeDir, "agent-standards", "standards") // Default directory
+// NewFileStandardLoader creates a new FileStandardLoader instance reading the standards folder standardsDir.
+// The folder is resolved once by the configuration, see config.Config.GetFolder, so that the loader scans
+// the folder reported everywhere else and traversal checks compare absolute paths.
+func NewFileStandardLoader(standardsDir string) *FileStandardLoader {
+	recursive := getRecursiveScan()
+	maxDepth := getMaxDepth()
+
+	scans := newScanCache()
+	if getSharedScanCache() {
+		scans = sharedScans
 	}
 
 	return &FileStandardLoader{
 		standardsDir: standardsDir,
+		recursive:    recursive,
+		maxDepth:     maxDepth,
+		nameStrategy: getNameStrategy(),
+		transform:    getContentTransform(),
+		reader:       newRetryingReader(),
+		strict:       getStrictMode(),
+		folderSeen:   atomic.Bool{},
+		cacheTTL:     getCacheTTL(),
+		scans:        scans,
+		scanKey:      scanCacheKey(standardsDir, recursive, maxDepth),
 	}
 }
 
 // ListStandards returns a list of available standard information (name and description).
-func (l *FileStandardLoader) ListStandards(_ context.Context) ([]domain.StandardInfo, error) {
+func (l *FileStandardLoader) ListStandards(ctx context.Context) ([]domain.StandardInfo, error) {
 	// Find all standard files
-	filePaths, err := l.findStandardFiles()
+	filePaths, err := l.findStandardFiles(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find standard files: %w", err)
 	}
 
 	// Validate all files first
-	if err := validateStandardFiles(filePaths, l.standardsDir); err != nil {
+	validPaths, err := l.validStandardFiles(ctx, filePaths)
+	if errors.Is(err, os.ErrNotExist) && l.cacheTTL > 0 {
+		// A cached scan may list files deleted since, scan again
+		l.resetScan()
+		if filePaths, err = l.findStandardFiles(ctx); err != nil {
+			return nil, fmt.Errorf("failed to find standard files: %w", err)
+		}
+		validPaths, err = l.validStandardFiles(ctx, filePaths)
+	}
+	filePaths = validPaths
+	if err != nil {
 		return nil, fmt.Errorf("failed to validate standard files: %w", err)
 	}
 
 	// Pre-allocate slice with known capacity
 	standardInfos := make([]domain.StandardInfo, 0, len(filePaths))
 
-	for _, filePath := range filePaths {
+	for i, filePath := range filePaths {
+		// Stop early if the request was cancelled
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("standards loading cancelled: %w", err)
+		}
+
 		// Sanitize file path to prevent path traversal attacks
 		cleanPath := filepath.Clean(filePath)
 
 		// Read file content (files already validated by ValidateStandardFiles above)
-		content, err := os.ReadFile(cleanPath)
+		content, err := l.readContent(ctx, cleanPath)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read file %s: %w", cleanPath, err)
 		}
+		if l.isBinaryStandard(ctx, filePath, content) {
+			shared.ReportProgress(ctx, i+1, len(filePaths))
+			continue
+		}
 
 		// Parse frontmatter
-		description, _, err := parseFrontmatter(string(content))
+		fm, _, err := parseFrontmatterData(string(content))
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse frontmatter for %s: %w", filePath, err)
 		}
 
-		// Extract standard name from file path
-		standardName := extractStandardName(filePath)
+		// Derive standard name from file path and frontmatter
+		standardName := l.standardName(filePath, fm)
+
+		_, language := splitLanguage(filePath)
 
 		standardInfo := domain.StandardInfo{
-			Name:        standardName,
-			Description: description,
+			Name:         standardName,
+			Description:  fm.description,
+			Path:         l.relativePath(filePath),
+			Language:     language,
+			Languages:    nil,
+			ReviewBy:     fm.reviewByDate,
+			Disabled:     fm.disabled(),
+			Tags:         fm.Tags,
+			When:         fm.When,
+			Order:        fm.Order,
+			Descriptions: fm.descriptions,
 		}
 
 		standardInfos = append(standardInfos, standardInfo)
+		shared.ReportProgress(ctx, i+1, len(filePaths))
 	}
 
 	return standardInfos, nil
 }
 
+// validStandardFiles validates the found standard files and returns those to read. Outside strict mode
+// an oversized file does not fail the validation, it is skipped with a warning, the same as by GetStandards.
+func (l *FileStandardLoader) validStandardFiles(ctx context.Context, filePaths []string) ([]string, error) {
+	if !l.strict {
+		validPaths := make([]string, 0, len(filePaths))
+		for _, filePath := range filePaths {
+			err := validateFile(filePath, l.standardsDir)
+			if errors.Is(err, domain.ErrFileTooLarge) {
+				skipped := skippedStandard(l.standardName(filePath, frontmatterData{}))
+				shared.LoggerFrom(ctx).Warn("Skipping oversized standard",
+					"standard", skipped.Name, "file_path", filePath, "reason", skipped.Reason, "error", err)
+				continue
+			}
+			validPaths = append(validPaths, filePath)
+		}
+		filePaths = validPaths
+	}
+
+	if err := validateStandardFiles(filePaths, l.standardsDir); err != nil {
+		return nil, err
+	}
+
+	return filePaths, nil
+}
+
+// GetStandard returns the full content of a single standard by its name.
+// Of several language variants, the untagged one is returned. domain.ErrStandardNotFound is returned for unknown names.
+func (l *FileStandardLoader) GetStandard(ctx context.Context, standardName string) (domain.Standard, error) {
+	index, err := l.buildStandardIndex(ctx)
+	if err != nil {
+		return domain.Standard{}, fmt.Errorf("failed to index standard files: %w", err)
+	}
+
+	variants, err := l.getStandardVariants(ctx, standardName, index)
+	if err != nil {
+		return domain.Standard{}, err
+	}
+
+	return primaryVariant(variants), nil
+}
+
 // GetStandards returns the full content of specific standards by their names.
-func (l *FileStandardLoader) GetStandards(_ context.Context, standardNames []string) ([]domain.Standard, error) {
+// All language variants of a name are returned, names without a standard are left out.
+// Standards skipped outside strict mode because they are oversized are returned separately.
+func (l *FileStandardLoader) GetStandards(
+	ctx context.Context, standardNames []string,
+) ([]domain.Standard, []domain.SkippedStandard, error) {
 	// Pre-allocate slice with known capacity
 	standards := make([]domain.Standard, 0, len(standardNames))
+	if len(standardNames) == 0 {
+		return standards, nil, nil
+	}
 
+	// The index is built once for the whole batch
+	index, err := l.buildStandardIndex(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to index standard files: %w", err)
+	}
+
+	var skipped []domain.SkippedStandard
 	for _, standardName := range standardNames {
-		// Construct file path
-		filePath := filepath.Join(l.standardsDir, standardName+".md")
+		variants, err := l.getStandardVariants(ctx, standardName, index)
+		if errors.Is(err, domain.ErrStandardNotFound) {
+			continue
+		}
+		if errors.Is(err, domain.ErrStandardSkipped) {
+			skipped = append(skipped, skippedStandard(standardName))
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		standards = append(standards, variants...)
+	}
 
-		// Validate the file
-		if err := validateFile(filePath, l.standardsDir); err != nil {
-			// If file doesn't exist, just skip it (don't return error)
-			if errors.Is(err, os.ErrNotExist) {
-				continue
-			}
-			return nil, fmt.Errorf("failed to validate standard file %s: %w", standardName, err)
+	return standards, skipped, nil
+}
+
+// GetStandardByPath returns the full content of the standard file at a slash-separated path relative to the
+// standards folder. Paths leaving the folder return domain.ErrPathTraversal, and paths that are not scanned as
+// standards, e.g. hidden, ignored or non-markdown files, return domain.ErrStandardNotFound.
+func (l *FileStandardLoader) GetStandardByPath(ctx context.Context, relPath string) (domain.Standard, error) {
+	cleanPath := path.Clean(filepath.ToSlash(relPath))
+	if filepath.IsAbs(relPath) || path.IsAbs(cleanPath) || cleanPath == ".." || strings.HasPrefix(cleanPath, "../") {
+		return domain.Standard{}, fmt.Errorf("%w: %s", domain.ErrPathTraversal, relPath)
+	}
+
+	if !l.isScannedPath(cleanPath) {
+		return domain.Standard{}, fmt.Errorf("%w: %s", domain.ErrStandardNotFound, relPath)
+	}
+
+	rules, err := loadIgnoreRules(l.standardsDir)
+	if err != nil {
+		return domain.Standard{}, err
+	}
+	if rules.excludes(cleanPath) {
+		return domain.Standard{}, fmt.Errorf("%w: %s", domain.ErrStandardNotFound, relPath)
+	}
+
+	filePath := filepath.Join(l.standardsDir, filepath.FromSlash(cleanPath))
+	standard, found, err := l.readStandard(ctx, deriveStandardName(l.nameStrategy, cleanPath, frontmatterData{}), filePath)
+	if errors.Is(err, domain.ErrFileTooLarge) && !l.strict {
+		shared.LoggerFrom(ctx).Warn("Skipping oversized standard", "file_path", cleanPath, "error", err)
+		return domain.Standard{}, newSkippedError(relPath)
+	}
+	if err != nil {
+		return domain.Standard{}, err
+	}
+	if !found {
+		return domain.Standard{}, fmt.Errorf("%w: %s", domain.ErrStandardNotFound, relPath)
+	}
+
+	// The title is only known once the file is read
+	if l.nameStrategy == config.NameStrategyTitleSlug {
+		var fm frontmatterData
+		fm.Title = standard.Title
+		standard.Name = deriveStandardName(l.nameStrategy, cleanPath, fm)
+	}
+
+	return standard, nil
+}
+
+// isScannedPath reports whether a clean slash-separated path relative to the standards folder is one the
+// folder scan would pick up: a visible markdown or encrypted markdown file within the scanned directory depth.
+func (l *FileStandardLoader) isScannedPath(relPath string) bool {
+	if path.Ext(decryptedPath(relPath)) != ".md" {
+		return false
+	}
+
+	segments := strings.Split(relPath, "/")
+	for _, segment := range segments {
+		if strings.HasPrefix(segment, ".") {
+			return false
 		}
+	}
 
-		// Read file content
-		cleanPath := filepath.Clean(filePath)
-		content, err := os.ReadFile(cleanPath)
+	dirDepth := len(segments) - 1
+	if dirDepth > 0 && (!l.recursive || (l.maxDepth > 0 && dirDepth > l.maxDepth)) {
+		return false
+	}
+
+	return true
+}
+
+// getStandardVariants reads all language variants of a standard. Standards are resolved through an index,
+// so that names derived by any strategy and all language variants of a name can be found.
+func (l *FileStandardLoader) getStandardVariants(
+	ctx context.Context, standardName string, index map[string][]string,
+) ([]domain.Standard, error) {
+	// Stop early if the request was cancelled
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("standards loading cancelled: %w", err)
+	}
+
+	filePaths := index[standardName]
+	if len(filePaths) == 0 && l.nameStrategy == config.NameStrategyFilename {
+		// File names can address a file directly, e.g. a single language variant,
+		// unless it is ignored or outside the scanned part of the folder
+		rules, err := loadIgnoreRules(l.standardsDir)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read standard file %s: %w", standardName, err)
+			return nil, err
+		}
+		if l.isScannedPath(standardName+".md") && !rules.excludes(standardName+".md") {
+			filePaths = []string{filepath.Join(l.standardsDir, filepath.FromSlash(standardName)+".md")}
 		}
+	}
 
-		// Parse frontmatter
-		description, standardContent, err := parseFrontmatter(string(content))
+	if len(filePaths) == 0 {
+		shared.LoggerFrom(ctx).Debug("No standard file matches the name",
+			"standard", standardName, "standards_dir", l.absoluteDir(), "name_strategy", l.nameStrategy)
+	}
+
+	variants := make([]domain.Standard, 0, len(filePaths))
+	skipped := false
+	for _, filePath := range filePaths {
+		standard, found, err := l.readStandard(ctx, standardName, filePath)
+		if errors.Is(err, domain.ErrFileTooLarge) && !l.strict {
+			shared.LoggerFrom(ctx).Warn("Skipping oversized standard",
+				"standard", standardName, "file_path", filePath, "error", err)
+			skipped = true
+			continue
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse frontmatter for standard %s: %w", standardName, err)
+			return nil, err
 		}
+		if found {
+			variants = append(variants, standard)
+		}
+	}
+
+	if len(variants) == 0 && skipped {
+		return nil, newSkippedError(standardName)
+	}
+	if len(variants) == 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrStandardNotFound, standardName)
+	}
 
-		standard := domain.Standard{
-			Name:        standardName,
-			Description: description,
-			Content:     standardContent,
+	return variants, nil
+}
+
+// primaryVariant returns the untagged language variant of a standard, or the first variant if all are tagged.
+func primaryVariant(variants []domain.Standard) domain.Standard {
+	for _, variant := range variants {
+		if variant.Language == "" {
+			return variant
+		}
+	}
+
+	return variants[0]
+}
+
+// readStandard reads a single standard file. It reports false if the file does not exist.
+// The attempted path and the validation result are logged at debug level to diagnose mismatched names.
+func (l *FileStandardLoader) readStandard(
+	ctx context.Context, standardName, filePath string,
+) (domain.Standard, bool, error) {
+	// Validate the file
+	err := validateFile(filePath, l.standardsDir)
+	shared.LoggerFrom(ctx).Debug("Resolved standard file", "standard", standardName, "file_path", filePath,
+		"standards_dir", l.absoluteDir(), "valid", err == nil, "error", err)
+	if err != nil {
+		// If file doesn't exist, just skip it (don't return error)
+		if errors.Is(err, os.ErrNotExist) {
+			return domain.Standard{}, false, nil
 		}
+		return domain.Standard{}, false, fmt.Errorf("failed to validate standard file %s: %w", standardName, err)
+	}
+
+	// Read file content
+	cleanPath := filepath.Clean(filePath)
+	content, err := l.readContent(ctx, cleanPath)
+	if err != nil {
+		return domain.Standard{}, false, fmt.Errorf("failed to read standard file %s: %w", standardName, err)
+	}
+
+	if l.isBinaryStandard(ctx, filePath, content) {
+		return domain.Standard{}, false, nil
+	}
 
-		standards = append(standards, standard)
+	// Parse frontmatter
+	parsed, err := parseStandard(string(content))
+	if err != nil {
+		return domain.Standard{}, false, fmt.Errorf("failed to parse frontmatter for standard %s: %w", standardName, err)
+	}
+	fm := parsed.fm
+
+	_, language := splitLanguage(filePath)
+
+	return domain.Standard{
+		Name:         standardName,
+		Description:  fm.description,
+		Content:      l.transform.serve(ctx, parsed),
+		Language:     language,
+		Priority:     fm.Priority,
+		Disabled:     fm.disabled(),
+		Title:        fm.Title,
+		Tags:         fm.Tags,
+		When:         fm.When,
+		ReviewBy:     fm.reviewByDate,
+		Descriptions: fm.descriptions,
+	}, true, nil
+}
+
+// readContent reads the content of a standard file, decrypting encrypted standard files.
+func (l *FileStandardLoader) readContent(ctx context.Context, filePath string) ([]byte, error) {
+	content, err := l.reader.readFile(ctx, filePath)
+	if err != nil || !isEncryptedPath(filePath) {
+		return content, err
 	}
 
-	return standards, nil
+	return decryptStandard(l.relativePath(filePath), content)
 }
 
 // extractStandardName extracts the standard name from a file path by removing the directory and extension.
@@ -135,13 +429,265 @@ func extractStandardName(filePath string) string {
 	return base
 }
 
-// findStandardFiles finds all markdown files in the standards directory, excluding hidden files.
-func (l *FileStandardLoader) findStandardFiles() ([]string, error) {
-	entries, err := os.ReadDir(l.standardsDir)
+// buildStandardIndex maps standard names derived by the configured name strategy to the file paths
+// of their language variants. If several files map to the same name and language, the first one found wins.
+func (l *FileStandardLoader) buildStandardIndex(ctx context.Context) (map[string][]string, error) {
+	filePaths, err := l.findStandardFiles(ctx)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return []string{}, nil // Empty directory is fine
+		return nil, fmt.Errorf("failed to find standard files: %w", err)
+	}
+
+	index, err := l.indexStandardFiles(ctx, filePaths)
+	if errors.Is(err, os.ErrNotExist) && l.cacheTTL > 0 {
+		// A cached scan may list files deleted since, scan again
+		l.resetScan()
+		if filePaths, err = l.findStandardFiles(ctx); err != nil {
+			return nil, fmt.Errorf("failed to find standard files: %w", err)
 		}
+		index, err = l.indexStandardFiles(ctx, filePaths)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// indexStandardFiles maps the standard names of the files to their file paths, see buildStandardIndex.
+func (l *FileStandardLoader) indexStandardFiles(ctx context.Context, filePaths []string) (map[string][]string, error) {
+	index := make(map[string][]string, len(filePaths))
+	seen := make(map[string]struct{}, len(filePaths))
+
+	for _, filePath := range filePaths {
+		var fm frontmatterData
+
+		// Only the title strategy needs the file content to derive a name
+		if l.nameStrategy == config.NameStrategyTitleSlug {
+			var skip bool
+			var err error
+			if fm, skip, err = l.readTitleFrontmatter(ctx, filePath); err != nil {
+				return nil, err
+			}
+			if skip {
+				continue
+			}
+		}
+
+		name := l.standardName(filePath, fm)
+		_, language := splitLanguage(filePath)
+
+		key := name + "\x00" + language
+		if _, exists := seen[key]; exists {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		index[name] = append(index[name], filePath)
+	}
+
+	return index, nil
+}
+
+// readTitleFrontmatter reads the frontmatter of a standard file to derive its name by title.
+// It reports true for a binary file, which is not a standard. An oversized file skipped outside strict mode
+// has no frontmatter, so that its name falls back to the file name and a request for it reports the skip.
+func (l *FileStandardLoader) readTitleFrontmatter(
+	ctx context.Context, filePath string,
+) (frontmatterData, bool, error) {
+	var fm frontmatterData
+
+	err := validateFile(filePath, l.standardsDir)
+	if errors.Is(err, domain.ErrFileTooLarge) && !l.strict {
+		return fm, false, nil
+	}
+	if err != nil {
+		return fm, false, fmt.Errorf("validation failed for %s: %w", filePath, err)
+	}
+
+	content, err := l.readContent(ctx, filepath.Clean(filePath))
+	if err != nil {
+		return fm, false, fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+	if l.isBinaryStandard(ctx, filePath, content) {
+		return fm, true, nil
+	}
+
+	if fm, _, err = parseFrontmatterData(string(content)); err != nil {
+		return fm, false, fmt.Errorf("failed to parse frontmatter for %s: %w", filePath, err)
+	}
+
+	return fm, false, nil
+}
+
+// standardName derives the standard name from a file path according to the configured name strategy.
+// Standards in nested directories are prefixed by their slash-separated directory relative to the standards directory.
+func (l *FileStandardLoader) standardName(filePath string, fm frontmatterData) string {
+	return deriveStandardName(l.nameStrategy, l.relativePath(filePath), fm)
+}
+
+// deriveStandardName derives the standard name from a slash-separated path relative to the standards root
+// according to the name strategy. Standards in nested directories are prefixed by their directory.
+func deriveStandardName(strategy config.NameStrategy, relPath string, fm frontmatterData) string {
+	// Language variants share the name of the standard they translate
+	relPath, _ = splitLanguage(relPath)
+
+	var name string
+	switch strategy {
+	case config.NameStrategyFirstDot:
+		name = extractFirstDotName(relPath)
+	case config.NameStrategyTitleSlug:
+		name = shared.Slugify(fm.Title)
+		if name == "" {
+			// Fall back to the file name when there is no usable title
+			name = extractStandardName(relPath)
+		}
+	case config.NameStrategyFilename:
+		name = extractStandardName(relPath)
+	default:
+		name = extractStandardName(relPath)
+	}
+
+	dir := path.Dir(relPath)
+	if dir == "." {
+		return name
+	}
+
+	return path.Join(dir, name)
+}
+
+// absoluteDir returns the absolute path of the standards directory, or the configured path if it cannot be resolved.
+func (l *FileStandardLoader) absoluteDir() string {
+	absDir, err := filepath.Abs(l.standardsDir)
+	if err != nil {
+		return l.standardsDir
+	}
+
+	return absDir
+}
+
+// relativePath returns the slash-separated path of a file relative to the standards directory.
+func (l *FileStandardLoader) relativePath(filePath string) string {
+	rel, err := filepath.Rel(l.standardsDir, filePath)
+	if err != nil {
+		return filepath.Base(filePath)
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// isStandardFile reports whether a directory entry is a visible markdown file or encrypted markdown file.
+func isStandardFile(entry fs.DirEntry) bool {
+	// Skip hidden files
+	if strings.HasPrefix(entry.Name(), ".") {
+		return false
+	}
+
+	// Only include regular files
+	if !entry.Type().IsRegular() {
+		return false
+	}
+
+	// Only include markdown files, encrypted ones included
+	return filepath.Ext(decryptedPath(entry.Name())) == ".md"
+}
+
+// splitLanguage splits an ISO 639-1 language tag from a standard file path when language variants are enabled,
+// e.g. "error-handling.ru.md" yields "error-handling.md" and "ru".
+// Paths without a language tag are returned unchanged with an empty language.
+// Encrypted standard files are split by the path of the markdown file they hold, e.g. "error-handling.md".
+func splitLanguage(filePath string) (string, string) {
+	filePath = decryptedPath(filePath)
+	if !getLanguageVariants() {
+		return filePath, ""
+	}
+
+	ext := filepath.Ext(filePath)
+	stem := strings.TrimSuffix(filePath, ext)
+
+	language := strings.TrimPrefix(filepath.Ext(stem), ".")
+	if !config.IsLanguageTag(language) {
+		return filePath, ""
+	}
+
+	// A file named only by a language tag is not a variant
+	base := filepath.Base(strings.TrimSuffix(stem, "."+language))
+	if base == "" || strings.HasPrefix(base, ".") {
+		return filePath, ""
+	}
+
+	return strings.TrimSuffix(stem, "."+language) + ext, language
+}
+
+// extractFirstDotName extracts the standard name from a file path by keeping the file name up to the first dot.
+func extractFirstDotName(filePath string) string {
+	base := filepath.Base(filePath)
+
+	// Leading dots belong to the name, not to an extension
+	trimmed := strings.TrimLeft(base, ".")
+	if i := strings.Index(trimmed, "."); i > 0 {
+		return base[:len(base)-len(trimmed)+i]
+	}
+
+	return base
+}
+
+// findStandardFiles finds all markdown files in the standards directory, excluding hidden files
+// and files matching the patterns of the .standardsignore file. Binary files are skipped once read.
+// A missing directory is empty, unless it has been read before, then domain.ErrFolderDisappeared is returned.
+func (l *FileStandardLoader) findStandardFiles(ctx context.Context) ([]string, error) {
+	if l.cacheTTL <= 0 {
+		return l.scanStandardFiles(ctx)
+	}
+
+	return l.scans.get(ctx, l.scanKey, l.cacheTTL, l.scanStandardFiles)
+}
+
+// resetScan drops the cached scan, so that the next request scans the standards folder again.
+// With a shared scan cache, the scan is dropped for all loaders of the folder.
+func (l *FileStandardLoader) resetScan() {
+	l.scans.reset(l.scanKey)
+}
+
+// scanStandardFiles scans the standards folder for standard files.
+func (l *FileStandardLoader) scanStandardFiles(ctx context.Context) ([]string, error) {
+	rules, err := loadIgnoreRules(l.standardsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	if l.recursive {
+		files, err = l.walkStandardFiles(ctx, rules)
+	} else {
+		files, err = l.readStandardFiles(rules)
+	}
+
+	if errors.Is(err, os.ErrNotExist) && !l.folderExists() {
+		if l.folderSeen.Load() {
+			return nil, fmt.Errorf("%w: %s", domain.ErrFolderDisappeared, l.standardsDir)
+		}
+		return []string{}, nil // Empty directory is fine
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	l.folderSeen.Store(true)
+
+	return files, nil
+}
+
+// folderExists reports whether the standards directory exists.
+func (l *FileStandardLoader) folderExists() bool {
+	_, err := os.Stat(l.standardsDir)
+	return !errors.Is(err, os.ErrNotExist)
+}
+
+// readStandardFiles finds all markdown files in the standards directory, excluding hidden files, ignored files
+// and subdirectories.
+func (l *FileStandardLoader) readStandardFiles(rules ignoreRules) ([]string, error) {
+	entries, err := os.ReadDir(l.standardsDir)
+	if err != nil {
 		return nil, fmt.Errorf("failed to read standards directory %s: %w", l.standardsDir, err)
 	}
 
@@ -149,23 +695,55 @@ func (l *FileStandardLoader) findStandardFiles() ([]string, error) {
 	files := make([]string, 0, len(entries))
 
 	for _, entry := range entries {
-		// Skip hidden files and directories
-		if strings.HasPrefix(entry.Name(), ".") {
+		if !isStandardFile(entry) || rules.matches(entry.Name(), false) {
 			continue
 		}
 
-		// Only include regular files
-		if !entry.Type().IsRegular() {
-			continue
+		filePath := filepath.Join(l.standardsDir, entry.Name())
+		files = append(files, filePath)
+	}
+
+	return files, nil
+}
+
+// walkStandardFiles finds all markdown files in the standards directory and its subdirectories,
+// excluding hidden and ignored files and directories. Directories nested deeper than the maximum
+// depth are skipped with a warning, to bound the cost of scanning pathological trees.
+func (l *FileStandardLoader) walkStandardFiles(ctx context.Context, rules ignoreRules) ([]string, error) {
+	var files []string
+
+	err := filepath.WalkDir(l.standardsDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
 		}
 
-		// Only include markdown files
-		if filepath.Ext(entry.Name()) != ".md" {
-			continue
+		if entry.IsDir() {
+			if path == l.standardsDir {
+				return nil
+			}
+
+			// Skip hidden and ignored directories
+			relPath := l.relativePath(path)
+			if strings.HasPrefix(entry.Name(), ".") || rules.matches(relPath, true) {
+				return filepath.SkipDir
+			}
+
+			if depth := strings.Count(relPath, "/") + 1; l.maxDepth > 0 && depth > l.maxDepth {
+				shared.LoggerFrom(ctx).Warn("Skipping directory beyond the maximum depth",
+					"directory", relPath, "max_depth", l.maxDepth)
+				return filepath.SkipDir
+			}
+			return nil
 		}
 
-		filePath := filepath.Join(l.standardsDir, entry.Name())
-		files = append(files, filePath)
+		if isStandardFile(entry) && !rules.matches(l.relativePath(path), false) {
+			files = append(files, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk standards directory %s: %w", l.standardsDir, err)
 	}
 
 	return files, nil
@@ -4,46 +4,135 @@
 package standards
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"io"
 	"strings"
+	"time"
 
+	"github.com/n-r-w/agent-standards-mcp/internal/condition"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
 	"gopkg.in/yaml.v3"
 )
 
-// frontmatterData represents the YAML frontmatter structure we expect
+// frontmatterData represents the YAML frontmatter structure we expect.
+// The schema tag documents each field in the generated frontmatter JSON Schema.
 type frontmatterData struct {
-	Description string `yaml:"description"`
+	Description i18nText `yaml:"description" schema:"Short summary, or a map of languages to summaries"`
+	Name        string   `yaml:"name" schema:"Standard name of a document in a combined standards file"`
+	Title       string   `yaml:"title" schema:"Human-readable title, used by the title-slug name strategy"`
+	ReviewBy    string   `yaml:"review_by" schema:"Date the standard must be reviewed by, RFC3339 or YYYY-MM-DD"`
+	Priority    float64  `yaml:"priority" schema:"Importance from 0 (optional) to 1 (required), used as content priority"`
+	Enabled     *bool    `yaml:"enabled" schema:"Set to false to stage the standard without serving it, true if absent"`
+	Tags        []string `yaml:"tags" schema:"Keywords to filter list_standards by, e.g. security or go"`
+	When        string   `yaml:"when" schema:"Condition on the request context, e.g. language == go, true if absent"`
+	Order       *int     `yaml:"order" schema:"Position in list_standards sorted by order, ascending, last if absent"`
+	SHA256      string   `yaml:"sha256" schema:"Hex SHA-256 of the content after the frontmatter, whitespace-trimmed"`
+
+	// description is the Description for the default language.
+	description string
+	// descriptions are the localized descriptions by language tag, nil if Description is a string.
+	descriptions map[string]string
+	// reviewByDate is the parsed ReviewBy date, zero if ReviewBy is empty.
+	reviewByDate time.Time
 }
 
 const (
 	// minimumFrontmatterLines is the minimum number of lines required for valid frontmatter
 	minimumFrontmatterLines = 3
+	// maxFrontmatterNodes is the maximum number of YAML nodes of frontmatter with all aliases expanded
+	maxFrontmatterNodes = 10000
+	// maxFrontmatterNesting is the maximum nesting depth of YAML nodes of frontmatter
+	maxFrontmatterNesting = 32
 	// oneMB is the default maximum standard file size in bytes
 	oneMB = 1024 * 1024
 	// defaultMaxStandards is the default maximum number of standard files
 	defaultMaxStandards = 100
+	// defaultMaxDepth is the default number of directory levels scanned below the standards directory
+	defaultMaxDepth = 10
+	// minPriority is the lowest priority of a standard
+	minPriority = 0.0
+	// maxPriority is the highest priority of a standard
+	maxPriority = 1.0
+	// reviewDateLayout is the date-only layout accepted for the review_by field
+	reviewDateLayout = "2006-01-02"
 )
 
+// parsedStandard is the result of parsing a standard file: the parsed frontmatter and the pieces of the
+// file it was parsed from, so that each tool can choose what to serve.
+type parsedStandard struct {
+	// fm is the parsed frontmatter, zero for a file without frontmatter.
+	fm frontmatterData
+	// body is the trimmed content after the frontmatter.
+	body string
+	// rawFrontmatter is the frontmatter block as authored, delimiters included, empty without frontmatter.
+	rawFrontmatter string
+	// fullContent is the file as authored.
+	fullContent string
+}
+
 // parseFrontmatter parses markdown content with optional YAML frontmatter.
 // It extracts the description field from frontmatter and returns the description
 // and content separately. If no frontmatter is present, description will be empty.
 func parseFrontmatter(content string) (description string, parsedContent string, err error) {
+	parsed, err := parseStandard(content)
+	if err != nil {
+		return "", "", err
+	}
+
+	return parsed.fm.description, parsed.body, nil
+}
+
+// parseFrontmatterData parses markdown content with optional YAML frontmatter.
+// It returns all supported frontmatter fields and the content separately.
+func parseFrontmatterData(content string) (fm frontmatterData, parsedContent string, err error) {
+	parsed, err := parseStandard(content)
+	if err != nil {
+		return frontmatterData{}, "", err
+	}
+
+	return parsed.fm, parsed.body, nil
+}
+
+// parseStandard parses markdown content with optional YAML frontmatter.
+// It returns the parsed frontmatter along with the body, the raw frontmatter block and the full content.
+// Standards without a frontmatter title take the first "# " heading of the body as the title when configured.
+func parseStandard(content string) (parsedStandard, error) {
+	parsed, err := splitStandard(content)
+	if err != nil {
+		return parsedStandard{}, err
+	}
+
+	if parsed.fm.Title == "" && getTitleFromHeading() {
+		parsed.fm.Title, parsed.body = titleFromHeading(parsed.body, getStripTitleHeading())
+	}
+
+	return parsed, nil
+}
+
+// splitStandard splits markdown content into the parsed frontmatter, the body, the raw frontmatter block
+// and the full content.
+func splitStandard(content string) (parsedStandard, error) {
+	parsed := parsedStandard{fm: frontmatterData{}, body: content, rawFrontmatter: "", fullContent: content}
+
 	// Handle empty content
 	if content == "" {
-		return "", "", nil
+		return parsed, nil
 	}
 
 	// Check if content starts with frontmatter delimiter
 	if !strings.HasPrefix(content, "---\n") && !strings.HasPrefix(content, "---\r\n") {
 		// No frontmatter, return content as-is with empty description
-		return "", content, nil
+		return parsed, nil
 	}
 
 	// Find the end of frontmatter
 	lines := strings.Split(content, "\n")
 	if len(lines) < minimumFrontmatterLines {
 		// Not enough lines for proper frontmatter
-		return "", content, nil
+		return parsed, nil
 	}
 
 	// Find the closing delimiter
@@ -57,36 +146,234 @@ func parseFrontmatter(content string) (description string, parsedContent string,
 
 	if endIndex == -1 {
 		// No closing delimiter found, treat as no frontmatter
-		return "", content, nil
+		return parsed, nil
 	}
 
 	// Extract frontmatter content
 	frontmatterLines := lines[1:endIndex]
 	frontmatterText := strings.Join(frontmatterLines, "\n")
 
-	// Parse YAML frontmatter
+	// Parse YAML frontmatter. Unknown fields are allowed, while duplicate keys and type mismatches fail
+	// the standard, as yaml.v3 rejects them by default.
+	// The document is decoded into nodes first, which keeps aliases unexpanded, so that alias bombs
+	// are rejected before they are expanded.
+	var node yaml.Node
+	decoder := yaml.NewDecoder(strings.NewReader(frontmatterText))
+	if err := decoder.Decode(&node); err != nil && !errors.Is(err, io.EOF) {
+		return parsedStandard{}, fmt.Errorf("invalid frontmatter YAML: %w", err)
+	}
+	if err := checkFrontmatterSize(&node); err != nil {
+		return parsedStandard{}, fmt.Errorf("invalid frontmatter YAML: %w", err)
+	}
+
 	var fm frontmatterData
-	err = yaml.Unmarshal([]byte(frontmatterText), &fm)
-	if err != nil {
-		return "", "", err
+	if node.Kind != 0 {
+		if err := node.Decode(&fm); err != nil {
+			return parsedStandard{}, fmt.Errorf("invalid frontmatter YAML: %w", err)
+		}
+	}
+
+	if err := fm.Description.validate(); err != nil {
+		return parsedStandard{}, err
+	}
+	fm.description, fm.descriptions = fm.Description.resolve(getDefaultLanguage())
+	fm.Name = strings.TrimSpace(fm.Name)
+	fm.Title = strings.TrimSpace(fm.Title)
+	fm.ReviewBy = strings.TrimSpace(fm.ReviewBy)
+	fm.When = strings.TrimSpace(fm.When)
+	fm.SHA256 = strings.TrimSpace(fm.SHA256)
+
+	var err error
+	if fm.reviewByDate, err = parseReviewDate(fm.ReviewBy); err != nil {
+		return parsedStandard{}, err
 	}
 
-	fm.Description = strings.TrimSpace(fm.Description)
+	if fm.Priority < minPriority || fm.Priority > maxPriority {
+		return parsedStandard{}, fmt.Errorf("frontmatter 'priority' must be between %g and %g, got %g",
+			minPriority, maxPriority, fm.Priority)
+	}
+
+	if fm.When != "" {
+		if _, err := condition.Parse(fm.When); err != nil {
+			return parsedStandard{}, fmt.Errorf("invalid frontmatter 'when': %w", err)
+		}
+	}
 
 	// Extract content after frontmatter
 	var contentLines []string
 	if endIndex+1 < len(lines) {
 		contentLines = lines[endIndex+1:]
 	}
-	parsedContent = strings.TrimSpace(strings.Join(contentLines, "\n"))
+	body := trimContent(strings.Join(contentLines, "\n"), getPreserveWhitespace())
+
+	if err := verifyChecksum(fm.SHA256, strings.Join(contentLines, "\n")); err != nil {
+		return parsedStandard{}, err
+	}
+
+	if fm.description == "" && !getEmptyDescriptionOK() {
+		return parsedStandard{}, errors.New("frontmatter 'description' cannot be empty")
+	}
+
+	if strings.TrimSpace(body) == "" {
+		return parsedStandard{}, errors.New("standard content cannot be empty")
+	}
+
+	parsed.fm = fm
+	parsed.body = body
+	parsed.rawFrontmatter = strings.Join(lines[:endIndex+1], "\n")
+
+	return parsed, nil
+}
+
+// titleFromHeading returns the text of the first "# " heading of the body outside fenced code blocks,
+// e.g. "Error Handling" for "# Error Handling #", and the body, without the heading if strip is set.
+// A body without such a heading has an empty title and is returned unchanged, as is a body that would
+// be left empty by stripping its heading.
+func titleFromHeading(body string, strip bool) (string, string) {
+	lines := strings.Split(body, "\n")
+
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence || !strings.HasPrefix(line, "# ") {
+			continue
+		}
+
+		// The optional closing sequence of hashes, preceded by a space, is not part of the heading text
+		title := strings.TrimSpace(line[2:])
+		if closed := strings.TrimRight(title, "#"); closed == "" || strings.HasSuffix(closed, " ") {
+			title = strings.TrimSpace(closed)
+		}
+		if title == "" {
+			continue
+		}
+		if !strip {
+			return title, body
+		}
+
+		rest := trimContent(strings.Join(append(lines[:i:i], lines[i+1:]...), "\n"), getPreserveWhitespace())
+		if strings.TrimSpace(rest) == "" {
+			return title, body
+		}
+		return title, rest
+	}
+
+	return "", body
+}
+
+// trimContent trims the whitespace surrounding the content of a standard. When whitespace is preserved,
+// only a single leading and trailing newline is trimmed, so that e.g. a standard starting with an indented
+// code block keeps its indentation.
+func trimContent(content string, preserveWhitespace bool) string {
+	if !preserveWhitespace {
+		return strings.TrimSpace(content)
+	}
+
+	content = strings.TrimPrefix(strings.TrimPrefix(content, "\r\n"), "\n")
+	if trimmed, ok := strings.CutSuffix(content, "\r\n"); ok {
+		return trimmed
+	}
+
+	return strings.TrimSuffix(content, "\n")
+}
+
+// verifyChecksum checks the content after the frontmatter against the sha256 frontmatter field.
+// The checksum is taken over the content with surrounding whitespace trimmed, so that it does not depend
+// on the trailing newline of the file or on AGENT_STANDARDS_MCP_PRESERVE_WHITESPACE. An empty checksum is not checked.
+func verifyChecksum(checksum, content string) error {
+	if checksum == "" {
+		return nil
+	}
+
+	sum := sha256.Sum256([]byte(strings.TrimSpace(content)))
+	if actual := hex.EncodeToString(sum[:]); !strings.EqualFold(checksum, actual) {
+		return fmt.Errorf("%w: frontmatter 'sha256' is %s, content hashes to %s",
+			domain.ErrChecksumMismatch, checksum, actual)
+	}
+
+	return nil
+}
+
+// disabled reports whether the standard is explicitly disabled by the enabled field.
+func (fm frontmatterData) disabled() bool {
+	return fm.Enabled != nil && !*fm.Enabled
+}
+
+// parseReviewDate parses the review_by frontmatter field in RFC3339 or YYYY-MM-DD format.
+// An empty value yields the zero time.
+func parseReviewDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if date, err := time.Parse(time.RFC3339, value); err == nil {
+		return date, nil
+	}
+
+	date, err := time.Parse(reviewDateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid frontmatter 'review_by' date %q: must be RFC3339 or YYYY-MM-DD", value)
+	}
+
+	return date, nil
+}
 
-	if fm.Description == "" {
-		return "", "", errors.New("frontmatter 'description' cannot be empty")
+// checkFrontmatterSize rejects frontmatter that expands to more than maxFrontmatterNodes YAML nodes
+// or nests deeper than maxFrontmatterNesting, counting each alias as a copy of its anchored node.
+// An alias inside the node it refers to is rejected, it would expand forever.
+func checkFrontmatterSize(root *yaml.Node) error {
+	type nodeSize struct {
+		nodes int
+		depth int
 	}
+	sizes := make(map[*yaml.Node]nodeSize)
+	// measuring holds the nodes whose children are being measured
+	measuring := make(map[*yaml.Node]bool)
 
-	if parsedContent == "" {
-		return "", "", errors.New("standard content cannot be empty")
+	var measure func(node *yaml.Node) (nodeSize, error)
+	measure = func(node *yaml.Node) (nodeSize, error) {
+		if size, ok := sizes[node]; ok {
+			return size, nil
+		}
+		if measuring[node] {
+			return nodeSize{}, errors.New("frontmatter contains an alias of a node inside itself")
+		}
+		measuring[node] = true
+		defer delete(measuring, node)
+
+		size := nodeSize{nodes: 1, depth: 1}
+		children := node.Content
+		if node.Kind == yaml.AliasNode && node.Alias != nil {
+			children = []*yaml.Node{node.Alias}
+		}
+		for _, child := range children {
+			childSize, err := measure(child)
+			if err != nil {
+				return nodeSize{}, err
+			}
+			// Saturate at the limit, nested aliases can overflow the count otherwise
+			size.nodes = min(size.nodes+childSize.nodes, maxFrontmatterNodes+1)
+			size.depth = max(size.depth, childSize.depth+1)
+		}
+
+		sizes[node] = size
+		return size, nil
+	}
+
+	size, err := measure(root)
+	if err != nil {
+		return err
+	}
+	if size.nodes > maxFrontmatterNodes {
+		return fmt.Errorf("frontmatter expands to more than %d nodes, check anchors and aliases", maxFrontmatterNodes)
+	}
+	if size.depth > maxFrontmatterNesting {
+		return fmt.Errorf("frontmatter nests deeper than %d levels", maxFrontmatterNesting)
 	}
 
-	return fm.Description, parsedContent, nil
+	return nil
 }
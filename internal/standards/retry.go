@@ -0,0 +1,59 @@
+package standards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/shared"
+)
+
+// defaultReadRetryDelay is the delay before the first retry of a failed read, doubled for each next retry.
+const defaultReadRetryDelay = 50 * time.Millisecond
+
+// fileReader reads the whole content of a file, os.ReadFile in production.
+type fileReader func(name string) ([]byte, error)
+
+// retryingReader retries reads failing with transient errors, as network filesystems produce them.
+// The zero retries value reads once, like os.ReadFile.
+type retryingReader struct {
+	read    fileReader
+	retries int
+	delay   time.Duration
+}
+
+// newRetryingReader creates a reader of files with the configured number of retries.
+func newRetryingReader() retryingReader {
+	return retryingReader{read: os.ReadFile, retries: getReadRetries(), delay: defaultReadRetryDelay}
+}
+
+// readFile reads the file, retrying transient errors with exponential backoff.
+// Other errors, e.g. a missing file or denied permission, are returned at once.
+func (r retryingReader) readFile(ctx context.Context, name string) ([]byte, error) {
+	delay := r.delay
+	for attempt := 0; ; attempt++ {
+		content, err := r.read(name)
+		if err == nil || attempt >= r.retries || !isTransientReadError(err) {
+			return content, err
+		}
+
+		shared.LoggerFrom(ctx).Warn("Retrying failed file read",
+			"file_path", name, "attempt", attempt+1, "retries", r.retries, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("file read cancelled: %w", ctx.Err())
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// isTransientReadError reports whether a read error may go away when the read is repeated.
+func isTransientReadError(err error) bool {
+	return errors.Is(err, syscall.EIO) || errors.Is(err, syscall.ETIMEDOUT) ||
+		errors.Is(err, syscall.EAGAIN) || errors.Is(err, syscall.EINTR)
+}
@@ -0,0 +1,71 @@
+package standards
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// sharedScans is the process-wide cache of folder scans used by loaders with a shared scan cache,
+// so that loaders of the same folder scan it once.
+//
+//nolint:gochecknoglobals // the cache is shared by all loaders of the process by design
+var sharedScans = newScanCache()
+
+// cachedScan is the list of standard files found by a scan of the standards folder.
+type cachedScan struct {
+	files     []string
+	scannedAt time.Time
+}
+
+// scanCache caches scans of standards folders by a key identifying the folder and the scan settings.
+type scanCache struct {
+	// mu guards entries and serializes scans, so that concurrent requests wait for a single scan.
+	mu      sync.Mutex
+	entries map[string]cachedScan
+}
+
+// newScanCache creates an empty scan cache.
+func newScanCache() *scanCache {
+	return &scanCache{mu: sync.Mutex{}, entries: make(map[string]cachedScan)}
+}
+
+// get returns the cached scan of the key while it is younger than ttl, and scans otherwise.
+func (c *scanCache) get(
+	ctx context.Context, key string, ttl time.Duration, scan func(context.Context) ([]string, error),
+) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cached, ok := c.entries[key]; ok && time.Since(cached.scannedAt) < ttl {
+		return slices.Clone(cached.files), nil
+	}
+
+	files, err := scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.entries[key] = cachedScan{files: files, scannedAt: time.Now()}
+
+	return slices.Clone(files), nil
+}
+
+// reset drops the cached scan of the key, so that the next request scans the folder again.
+func (c *scanCache) reset(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// scanCacheKey returns the key of the scans of a folder with the settings affecting which files are found.
+func scanCacheKey(standardsDir string, recursive bool, maxDepth int) string {
+	if absolute, err := filepath.Abs(standardsDir); err == nil {
+		standardsDir = absolute
+	}
+
+	return fmt.Sprintf("%s|recursive=%t|depth=%d", standardsDir, recursive, maxDepth)
+}
@@ -0,0 +1,101 @@
+package standards
+
+import (
+	"maps"
+	"reflect"
+	"strings"
+)
+
+// FrontmatterSchemaID is the identifier of the frontmatter JSON Schema document.
+const FrontmatterSchemaID = "schema://frontmatter"
+
+// nonBlankPattern matches strings with a character other than whitespace.
+const nonBlankPattern = `\S`
+
+// FrontmatterSchema returns a JSON Schema document describing the supported frontmatter fields.
+// The fields are generated from the frontmatterData struct, their constraints follow the checks of the parser.
+// Only the parser checks the syntax of the when condition and whether the sha256 checksum matches the content.
+// The description is required unless AGENT_STANDARDS_MCP_EMPTY_DESCRIPTION_OK is set. The schema applies
+// to frontmatter blocks only, files without frontmatter are accepted as well.
+func FrontmatterSchema() map[string]any {
+	fmType := reflect.TypeFor[frontmatterData]()
+
+	properties := make(map[string]any, fmType.NumField())
+	constraints := frontmatterConstraints()
+
+	for i := range fmType.NumField() {
+		field := fmType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		property := jsonSchemaType(field.Type)
+		if description := field.Tag.Get("schema"); description != "" {
+			property["description"] = description
+		}
+		maps.Copy(property, constraints[name])
+		properties[name] = property
+	}
+
+	required := []string{}
+	if !getEmptyDescriptionOK() {
+		required = append(required, "description")
+		if description, ok := properties["description"].(map[string]any); ok {
+			// The parser rejects an empty or whitespace-only description
+			description["pattern"] = nonBlankPattern
+		}
+	}
+
+	return map[string]any{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"$id":                  FrontmatterSchemaID,
+		"title":                "Standard frontmatter",
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": true,
+	}
+}
+
+// frontmatterConstraints returns the JSON Schema constraints of the frontmatter fields checked by the parser.
+func frontmatterConstraints() map[string]map[string]any {
+	return map[string]map[string]any{
+		"priority": {"minimum": minPriority, "maximum": maxPriority},
+		// RFC3339 or YYYY-MM-DD, see parseReviewDate
+		"review_by": {"anyOf": []map[string]any{{"format": "date-time"}, {"format": "date"}}},
+		"sha256":    {"pattern": `^\s*[0-9a-fA-F]{64}\s*$`},
+	}
+}
+
+// jsonSchemaType maps a Go type to its JSON Schema type description.
+func jsonSchemaType(t reflect.Type) map[string]any {
+	if t == reflect.TypeFor[i18nText]() {
+		// A string or a non-empty map of languages to non-blank strings, see i18nText.validate
+		return map[string]any{
+			"type":                 []string{"string", "object"},
+			"minProperties":        1,
+			"additionalProperties": map[string]any{"type": "string", "pattern": nonBlankPattern},
+		}
+	}
+
+	//nolint:exhaustive // only kinds used by frontmatter fields are mapped, the rest fall back to string
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": jsonSchemaType(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object"}
+	case reflect.Pointer:
+		// Optional fields are pointers to tell an absent value from the zero value
+		return jsonSchemaType(t.Elem())
+	default:
+		return map[string]any{"type": "string"}
+	}
+}
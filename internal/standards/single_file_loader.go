@@ -0,0 +1,281 @@
+package standards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+	"github.com/n-r-w/agent-standards-mcp/internal/shared"
+)
+
+// singleFileDocument is a standard parsed from a document of a combined standards file.
+type singleFileDocument struct {
+	name   string
+	parsed parsedStandard
+}
+
+// SingleFileStandardLoader implements the StandardLoader interface for loading standards from a single
+// combined file. The file holds several documents, each starting with its own YAML frontmatter block
+// naming the standard by a name or title field. The file is read on every call, so edits are picked up.
+type SingleFileStandardLoader struct {
+	filePath  string
+	transform contentTransform
+}
+
+// NewSingleFileStandardLoader creates a new SingleFileStandardLoader instance.
+func NewSingleFileStandardLoader() (*SingleFileStandardLoader, error) {
+	filePath := os.Getenv("AGENT_STANDARDS_MCP_SINGLE_FILE")
+	if filePath == "" {
+		return nil, errors.New("AGENT_STANDARDS_MCP_SINGLE_FILE is required for the single-file mode")
+	}
+
+	return &SingleFileStandardLoader{
+		filePath:  filePath,
+		transform: getContentTransform(),
+	}, nil
+}
+
+// ListStandards returns a list of available standard information (name and description).
+func (l *SingleFileStandardLoader) ListStandards(ctx context.Context) ([]domain.StandardInfo, error) {
+	documents, err := l.readDocuments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	standardInfos := make([]domain.StandardInfo, 0, len(documents))
+	for _, document := range documents {
+		standardInfos = append(standardInfos, domain.StandardInfo{
+			Name:         document.name,
+			Description:  document.parsed.fm.description,
+			Path:         filepath.Base(l.filePath),
+			Language:     "",
+			Languages:    nil,
+			ReviewBy:     document.parsed.fm.reviewByDate,
+			Disabled:     document.parsed.fm.disabled(),
+			Tags:         document.parsed.fm.Tags,
+			When:         document.parsed.fm.When,
+			Order:        document.parsed.fm.Order,
+			Descriptions: document.parsed.fm.descriptions,
+		})
+	}
+
+	return standardInfos, nil
+}
+
+// GetStandard returns the full content of a single standard by its name.
+// domain.ErrStandardNotFound is returned for unknown names.
+func (l *SingleFileStandardLoader) GetStandard(ctx context.Context, standardName string) (domain.Standard, error) {
+	documents, err := l.readDocuments(ctx)
+	if err != nil {
+		return domain.Standard{}, err
+	}
+
+	for _, document := range documents {
+		if document.name == standardName {
+			return l.standard(ctx, document), nil
+		}
+	}
+
+	return domain.Standard{}, fmt.Errorf("%w: %s", domain.ErrStandardNotFound, standardName)
+}
+
+// GetStandards returns the full content of specific standards by their names.
+// Names without a standard are left out. No standard is skipped, so the skipped standards are always nil.
+func (l *SingleFileStandardLoader) GetStandards(
+	ctx context.Context, standardNames []string,
+) ([]domain.Standard, []domain.SkippedStandard, error) {
+	standards := make([]domain.Standard, 0, len(standardNames))
+	if len(standardNames) == 0 {
+		return standards, nil, nil
+	}
+
+	documents, err := l.readDocuments(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byName := make(map[string]singleFileDocument, len(documents))
+	for _, document := range documents {
+		byName[document.name] = document
+	}
+
+	for _, standardName := range standardNames {
+		if document, ok := byName[standardName]; ok {
+			standards = append(standards, l.standard(ctx, document))
+		}
+	}
+
+	return standards, nil, nil
+}
+
+// standard returns the full standard of a parsed document.
+func (l *SingleFileStandardLoader) standard(ctx context.Context, document singleFileDocument) domain.Standard {
+	return domain.Standard{
+		Name:         document.name,
+		Description:  document.parsed.fm.description,
+		Content:      l.transform.serve(ctx, document.parsed),
+		Language:     "",
+		Priority:     document.parsed.fm.Priority,
+		Disabled:     document.parsed.fm.disabled(),
+		Title:        document.parsed.fm.Title,
+		Tags:         document.parsed.fm.Tags,
+		When:         document.parsed.fm.When,
+		ReviewBy:     document.parsed.fm.reviewByDate,
+		Descriptions: document.parsed.fm.descriptions,
+	}
+}
+
+// readDocuments reads the combined file and parses its documents. The whole file must fit into
+// the maximum size of a standard times the maximum number of standards, and each document into
+// the maximum size of a standard. If several documents have the same name, the first one wins.
+func (l *SingleFileStandardLoader) readDocuments(ctx context.Context) ([]singleFileDocument, error) {
+	// Stop early if the request was cancelled
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("standards loading cancelled: %w", err)
+	}
+
+	maxSize, err := getMaxStandardSize()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get max standard size: %w", err)
+	}
+
+	maxStandards, err := getMaxStandards()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get max standards: %w", err)
+	}
+
+	cleanPath := filepath.Clean(l.filePath)
+	fileInfo, err := os.Stat(cleanPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to access standards file: %w", err)
+	}
+
+	if maxFileSize := maxSize * int64(maxStandards); fileInfo.Size() > maxFileSize {
+		return nil, &FileTooLargeError{Path: l.filePath, Size: fileInfo.Size(), Limit: maxFileSize}
+	}
+
+	content, err := os.ReadFile(cleanPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read standards file: %w", err)
+	}
+
+	texts, err := splitDocuments(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to split standards file %s: %w", l.filePath, err)
+	}
+
+	if len(texts) > maxStandards {
+		return nil, fmt.Errorf("%w of %d: %d", domain.ErrTooManyStandards, maxStandards, len(texts))
+	}
+
+	documents := make([]singleFileDocument, 0, len(texts))
+	seen := make(map[string]struct{}, len(texts))
+
+	for i, text := range texts {
+		if int64(len(text)) > maxSize {
+			path := fmt.Sprintf("%s document %d", l.filePath, i+1)
+			return nil, &FileTooLargeError{Path: path, Size: int64(len(text)), Limit: maxSize}
+		}
+
+		parsed, err := parseStandard(text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse document %d: %w", i+1, err)
+		}
+
+		name := parsed.fm.Name
+		if name == "" {
+			name = shared.Slugify(parsed.fm.Title)
+		}
+		if name == "" {
+			return nil, fmt.Errorf("document %d has no name or title in its frontmatter", i+1)
+		}
+
+		if _, exists := seen[name]; exists {
+			continue
+		}
+		seen[name] = struct{}{}
+
+		documents = append(documents, singleFileDocument{name: name, parsed: parsed})
+	}
+
+	return documents, nil
+}
+
+// splitDocuments splits a combined standards file into documents, each starting with its frontmatter block.
+// A "---" line starts a new document only if it opens a frontmatter block with a name or title field,
+// so that horizontal rules and "---" lines in fenced code blocks stay part of the content.
+func splitDocuments(content string) ([]string, error) {
+	lines := strings.Split(content, "\n")
+
+	var delimiters []int
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if !inFence && trimmed == "---" {
+			delimiters = append(delimiters, i)
+		}
+	}
+
+	if len(delimiters) == 0 || strings.TrimSpace(strings.Join(lines[:delimiters[0]], "\n")) != "" {
+		return nil, errors.New("the file must start with a frontmatter block")
+	}
+
+	var documents []string
+	for i := 0; i < len(delimiters); {
+		if i+1 >= len(delimiters) {
+			return nil, fmt.Errorf("unterminated frontmatter block at line %d", delimiters[i]+1)
+		}
+
+		// The document runs until the next delimiter opening a frontmatter block
+		next := i + 2
+		for next < len(delimiters) && !opensFrontmatter(lines, delimiters, next) {
+			next++
+		}
+
+		end := len(lines)
+		if next < len(delimiters) {
+			end = delimiters[next]
+		}
+
+		documents = append(documents, strings.Join(lines[delimiters[i]:end], "\n"))
+		i = next
+	}
+
+	return documents, nil
+}
+
+// opensFrontmatter reports whether the delimiter at the index opens a frontmatter block,
+// that is, the lines up to the following delimiter are a YAML mapping with a name or title field.
+func opensFrontmatter(lines []string, delimiters []int, index int) bool {
+	if index+1 >= len(delimiters) {
+		return false
+	}
+
+	block := strings.Join(lines[delimiters[index]+1:delimiters[index+1]], "\n")
+
+	// Aliases are only expanded once the block is known to stay small
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(block), &node); err != nil || checkFrontmatterSize(&node) != nil {
+		return false
+	}
+
+	var fields map[string]any
+	if err := node.Decode(&fields); err != nil {
+		return false
+	}
+
+	_, hasName := fields["name"]
+	_, hasTitle := fields["title"]
+
+	return hasName || hasTitle
+}
@@ -1,11 +1,35 @@
 package standards
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+	"github.com/n-r-w/agent-standards-mcp/internal/shared"
 )
 
 func TestParseFrontmatter(t *testing.T) {
@@ -160,6 +184,159 @@ description: "   Valid description with spaces   "
 	}
 }
 
+func TestParseFrontmatter_PreserveWhitespace(t *testing.T) {
+	content := "---\ndescription: \"Indented\"\n---\n    func main() {}\n\nText\n\n"
+
+	tests := []struct {
+		name        string
+		preserve    string
+		wantContent string
+	}{
+		{"trimmed by default", "", "func main() {}\n\nText"},
+		{"trimmed", "false", "func main() {}\n\nText"},
+		{"preserved", "true", "    func main() {}\n\nText\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AGENT_STANDARDS_MCP_PRESERVE_WHITESPACE", tt.preserve)
+
+			_, gotContent, err := parseFrontmatter(content)
+			if err != nil {
+				t.Fatalf("ParseFrontmatter() error = %v", err)
+			}
+			if gotContent != tt.wantContent {
+				t.Errorf("ParseFrontmatter() gotContent = %q, wantContent %q", gotContent, tt.wantContent)
+			}
+		})
+	}
+
+	t.Run("whitespace-only content", func(t *testing.T) {
+		t.Setenv("AGENT_STANDARDS_MCP_PRESERVE_WHITESPACE", "true")
+
+		if _, _, err := parseFrontmatter("---\ndescription: \"Blank\"\n---\n   \n\n"); err == nil {
+			t.Error("ParseFrontmatter() expected error for whitespace-only content, got nil")
+		}
+	})
+}
+
+func TestParseFrontmatter_AliasBomb(t *testing.T) {
+	// Each level references the previous one nine times, expanding to 9^9 nodes
+	var builder strings.Builder
+	builder.WriteString("---\ndescription: Bomb\na: &a [" + strings.Repeat("lol, ", 8) + "lol]\n")
+	for level := 'b'; level <= 'i'; level++ {
+		references := strings.Repeat(fmt.Sprintf("*%c, ", level-1), 8) + fmt.Sprintf("*%c", level-1)
+		fmt.Fprintf(&builder, "%c: &%c [%s]\n", level, level, references)
+	}
+	builder.WriteString("---\nContent")
+
+	done := make(chan error, 1)
+	go func() {
+		_, _, err := parseFrontmatter(builder.String())
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil || !strings.Contains(err.Error(), "more than 10000 nodes") {
+			t.Errorf("parseFrontmatter() error = %v, expected the alias bomb to be rejected", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("parseFrontmatter() did not return for an alias bomb")
+	}
+}
+
+func TestParseFrontmatter_CyclicAlias(t *testing.T) {
+	content := "---\ndescription: x\na: &x [*x]\n---\nbody\n"
+
+	if _, _, err := parseFrontmatter(content); err == nil || !strings.Contains(err.Error(), "inside itself") {
+		t.Errorf("parseFrontmatter() error = %v, expected the cyclic alias to be rejected", err)
+	}
+	if _, err := parseStandard(content); err == nil {
+		t.Error("parseStandard() expected an error for a cyclic alias")
+	}
+
+	lines := strings.Split("---\nname: a\n---\nbody\n---\nname: b\na: &x [*x]\n---\nmore", "\n")
+	if opensFrontmatter(lines, []int{0, 2, 4, 7}, 2) {
+		t.Error("opensFrontmatter() = true for a cyclic alias")
+	}
+}
+
+func TestParseFrontmatter_Aliases(t *testing.T) {
+	content := "---\ndescription: &summary Shared summary\ntitle: *summary\n---\nContent"
+
+	fm, _, err := parseFrontmatterData(content)
+	if err != nil {
+		t.Fatalf("parseFrontmatterData() error = %v", err)
+	}
+	if fm.description != "Shared summary" || fm.Title != "Shared summary" {
+		t.Errorf("parseFrontmatterData() = %+v, expected the alias to be resolved", fm)
+	}
+}
+
+func TestParseFrontmatter_DeepNesting(t *testing.T) {
+	content := "---\ndescription: Deep\nextra: " + strings.Repeat("[", 40) + strings.Repeat("]", 40) + "\n---\nContent"
+
+	if _, _, err := parseFrontmatter(content); err == nil || !strings.Contains(err.Error(), "nests deeper") {
+		t.Errorf("parseFrontmatter() error = %v, expected deep nesting to be rejected", err)
+	}
+}
+
+func TestParseFrontmatter_EmptyDescription(t *testing.T) {
+	tests := []struct {
+		name        string
+		allowed     string
+		frontmatter string
+		wantErr     bool
+	}{
+		{name: "whitespace-only fails by default", allowed: "", frontmatter: "description: \"   \"\n", wantErr: true},
+		{name: "missing fails by default", allowed: "", frontmatter: "title: Rules\n", wantErr: true},
+		{name: "whitespace-only fails", allowed: "false", frontmatter: "description: \"   \"\n", wantErr: true},
+		{name: "whitespace-only allowed", allowed: "true", frontmatter: "description: \"   \"\n", wantErr: false},
+		{name: "empty allowed", allowed: "true", frontmatter: "description: \"\"\n", wantErr: false},
+		{name: "missing allowed", allowed: "true", frontmatter: "title: Rules\n", wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AGENT_STANDARDS_MCP_EMPTY_DESCRIPTION_OK", tt.allowed)
+
+			description, content, err := parseFrontmatter("---\n" + tt.frontmatter + "---\nContent")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFrontmatter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if description != "" || content != "Content" {
+				t.Errorf("ParseFrontmatter() = %q, %q, expected an empty description and the content", description, content)
+			}
+		})
+	}
+}
+
+func TestTrimContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		preserve bool
+		expected string
+	}{
+		{"all whitespace trimmed", "\n\n  content  \n\n", false, "content"},
+		{"single newlines trimmed", "\n\n  content  \n\n", true, "\n  content  \n"},
+		{"crlf newlines trimmed", "\r\n  content\r\n", true, "  content"},
+		{"no surrounding newlines", "  content", true, "  content"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := trimContent(tt.content, tt.preserve); got != tt.expected {
+				t.Errorf("trimContent() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestValidateFile(t *testing.T) {
 	// Create a temporary directory for test files
 	tempDir := t.TempDir()
@@ -200,6 +377,7 @@ func TestValidateFile(t *testing.T) {
 		name    string
 		setup   func() string
 		wantErr bool
+		errIs   error
 		errMsg  string
 	}{
 		{
@@ -213,6 +391,7 @@ func TestValidateFile(t *testing.T) {
 				return path
 			},
 			wantErr: false,
+			errIs:   nil,
 			errMsg:  "",
 		},
 		{
@@ -227,7 +406,24 @@ func TestValidateFile(t *testing.T) {
 				return path
 			},
 			wantErr: true,
-			errMsg:  "file size exceeds maximum limit",
+			errIs:   domain.ErrFileTooLarge,
+			errMsg:  "exceeds maximum limit of 1.0 KB (1024 bytes): " + filepath.Join(tempDir, "large.md") + " is 2.0 KB (2000 bytes)",
+		},
+		{
+			name: "multi-MB file too large",
+			setup: func() string {
+				path := filepath.Join(tempDir, "huge.md")
+				if err := os.WriteFile(path, nil, 0644); err != nil {
+					t.Fatalf("Failed to write test file: %v", err)
+				}
+				if err := os.Truncate(path, 3*oneMB); err != nil {
+					t.Fatalf("Failed to grow test file: %v", err)
+				}
+				return path
+			},
+			wantErr: true,
+			errIs:   domain.ErrFileTooLarge,
+			errMsg:  "huge.md is 3.0 MB (3145728 bytes)",
 		},
 		{
 			name: "path traversal attack - relative path",
@@ -235,7 +431,8 @@ func TestValidateFile(t *testing.T) {
 				return "../../../etc/passwd"
 			},
 			wantErr: true,
-			errMsg:  "path traversal detected",
+			errIs:   domain.ErrPathTraversal,
+			errMsg:  "is outside the allowed directory",
 		},
 		{
 			name: "path traversal attack - absolute path outside allowed",
@@ -243,7 +440,8 @@ func TestValidateFile(t *testing.T) {
 				return "/etc/passwd"
 			},
 			wantErr: true,
-			errMsg:  "path traversal detected",
+			errIs:   domain.ErrPathTraversal,
+			errMsg:  "/etc/passwd is outside the allowed directory",
 		},
 		{
 			name: "file does not exist",
@@ -251,7 +449,8 @@ func TestValidateFile(t *testing.T) {
 				return filepath.Join(tempDir, "nonexistent.md")
 			},
 			wantErr: true,
-			errMsg:  "file does not exist",
+			errIs:   os.ErrNotExist,
+			errMsg:  "",
 		},
 		{
 			name: "directory instead of file",
@@ -263,6 +462,7 @@ func TestValidateFile(t *testing.T) {
 				return path
 			},
 			wantErr: true,
+			errIs:   nil,
 			errMsg:  "path is not a file",
 		},
 		{
@@ -276,6 +476,7 @@ func TestValidateFile(t *testing.T) {
 				return path
 			},
 			wantErr: false,
+			errIs:   nil,
 			errMsg:  "",
 		},
 		{
@@ -289,6 +490,7 @@ func TestValidateFile(t *testing.T) {
 				return path
 			},
 			wantErr: false,
+			errIs:   nil,
 			errMsg:  "",
 		},
 	}
@@ -304,6 +506,9 @@ func TestValidateFile(t *testing.T) {
 				return
 			}
 
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("ValidateFile() error = %v, expected to wrap %v", err, tt.errIs)
+			}
 			if tt.wantErr && err != nil {
 				if tt.errMsg != "" && !contains(err.Error(), tt.errMsg) {
 					t.Errorf("ValidateFile() error = %v, expected to contain %v", err.Error(), tt.errMsg)
@@ -313,6 +518,41 @@ func TestValidateFile(t *testing.T) {
 	}
 }
 
+func TestCheckWithinDir(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "standards")
+
+	tests := []struct {
+		name          string
+		path          string
+		wantErr       bool
+		wantTraversal bool
+	}{
+		{name: "file in root", path: filepath.Join(root, "go.md")},
+		{name: "nested file", path: filepath.Join(root, "go", "errors.md")},
+		{name: "dotted name in root", path: filepath.Join(root, "..notes.md")},
+		{name: "inner dot segments", path: filepath.Join(root, "go") + "/../go.md"},
+		{name: "nonexistent file in root", path: filepath.Join(root, "missing.md")},
+		{name: "parent directory", path: filepath.Join(root, ".."), wantErr: true, wantTraversal: true},
+		{name: "relative escape", path: root + "/../secret.md", wantErr: true, wantTraversal: true},
+		{name: "absolute outside", path: "/etc/passwd", wantErr: true, wantTraversal: true},
+		{name: "sibling with shared prefix", path: root + "-other/go.md", wantErr: true, wantTraversal: true},
+		{name: "empty path", path: "", wantErr: true},
+		{name: "NUL byte", path: filepath.Join(root, "go\x00.md"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkWithinDir(tt.path, root)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkWithinDir(%q) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			}
+			if errors.Is(err, domain.ErrPathTraversal) != tt.wantTraversal {
+				t.Errorf("checkWithinDir(%q) error = %v, expected traversal %v", tt.path, err, tt.wantTraversal)
+			}
+		})
+	}
+}
+
 func TestValidateStandardFiles(t *testing.T) {
 	tempDir := t.TempDir()
 
@@ -334,7 +574,7 @@ func TestValidateStandardFiles(t *testing.T) {
 		setup        func() []string
 		maxStandards string
 		wantErr      bool
-		errMsg       string
+		errIs        error
 	}{
 		{
 			name: "valid number of files",
@@ -352,7 +592,7 @@ func TestValidateStandardFiles(t *testing.T) {
 			},
 			maxStandards: "5",
 			wantErr:      false,
-			errMsg:       "",
+			errIs:        nil,
 		},
 		{
 			name: "too many files",
@@ -370,7 +610,7 @@ func TestValidateStandardFiles(t *testing.T) {
 			},
 			maxStandards: "5",
 			wantErr:      true,
-			errMsg:       "number of files exceeds maximum limit",
+			errIs:        domain.ErrTooManyStandards,
 		},
 		{
 			name: "empty file list",
@@ -379,7 +619,7 @@ func TestValidateStandardFiles(t *testing.T) {
 			},
 			maxStandards: "5",
 			wantErr:      false,
-			errMsg:       "",
+			errIs:        nil,
 		},
 	}
 
@@ -397,10 +637,8 @@ func TestValidateStandardFiles(t *testing.T) {
 				return
 			}
 
-			if tt.wantErr && err != nil {
-				if tt.errMsg != "" && !contains(err.Error(), tt.errMsg) {
-					t.Errorf("ValidateStandardFiles() error = %v, expected to contain %v", err.Error(), tt.errMsg)
-				}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("ValidateStandardFiles() error = %v, expected to wrap %v", err, tt.errIs)
 			}
 		})
 	}
@@ -581,7 +819,7 @@ Good content`
 
 			tt.setup()
 
-			loader := NewFileStandardLoader()
+			loader := NewFileStandardLoader(tempDir)
 			got, err := loader.ListStandards(context.Background())
 
 			if (err != nil) != tt.wantErr {
@@ -747,8 +985,8 @@ Content 1`
 
 			tt.setup()
 
-			loader := NewFileStandardLoader()
-			got, err := loader.GetStandards(context.Background(), tt.standardNames)
+			loader := NewFileStandardLoader(tempDir)
+			got, _, err := loader.GetStandards(context.Background(), tt.standardNames)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("FileStandardLoader.GetStandards() error = %v, wantErr %v", err, tt.wantErr)
@@ -814,3 +1052,2568 @@ func TestExtractStandardName(t *testing.T) {
 		})
 	}
 }
+
+func TestFileStandardLoader_ListStandards_Recursive(t *testing.T) {
+	tempDir := t.TempDir()
+
+	t.Setenv("AGENT_STANDARDS_MCP_RECURSIVE", "true")
+
+	files := map[string]string{
+		"root.md":             "---\ndescription: \"Root standard\"\n---\nRoot content",
+		"go/errors.md":        "---\ndescription: \"Go errors\"\n---\nErrors content",
+		"go/testing/unit.md":  "---\ndescription: \"Go unit tests\"\n---\nUnit content",
+		".drafts/draft.md":    "---\ndescription: \"Hidden draft\"\n---\nDraft content",
+		"go/.hidden-draft.md": "---\ndescription: \"Hidden file\"\n---\nHidden content",
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create test directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	loader := NewFileStandardLoader(tempDir)
+	got, err := loader.ListStandards(context.Background())
+	if err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+
+	gotPaths := make(map[string]string, len(got))
+	for _, info := range got {
+		gotPaths[info.Name] = info.Path
+	}
+
+	expected := map[string]string{
+		"root":            "root.md",
+		"go/errors":       "go/errors.md",
+		"go/testing/unit": "go/testing/unit.md",
+	}
+	if len(gotPaths) != len(expected) {
+		t.Fatalf("FileStandardLoader.ListStandards() returned %v, expected %v", gotPaths, expected)
+	}
+	for name, path := range expected {
+		if gotPaths[name] != path {
+			t.Errorf("standard %s has path %q, expected %q", name, gotPaths[name], path)
+		}
+	}
+
+	// Nested standards are resolvable by their relative name
+	standards, _, err := loader.GetStandards(context.Background(), []string{"go/testing/unit"})
+	if err != nil {
+		t.Fatalf("FileStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 1 || standards[0].Content != "Unit content" {
+		t.Errorf("FileStandardLoader.GetStandards() returned %v, expected nested standard", standards)
+	}
+}
+
+func TestFileStandardLoader_ListStandards_MaxDepth(t *testing.T) {
+	tempDir := t.TempDir()
+
+	t.Setenv("AGENT_STANDARDS_MCP_RECURSIVE", "true")
+	t.Setenv("AGENT_STANDARDS_MCP_MAX_DEPTH", "2")
+
+	files := []string{"root.md", "a/one.md", "a/b/two.md", "a/b/c/three.md", "a/b/c/d/four.md"}
+	for _, name := range files {
+		path := filepath.Join(tempDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create test directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("---\ndescription: \"Standard\"\n---\nContent"), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+		AddSource:   false,
+		Level:       slog.LevelWarn,
+		ReplaceAttr: nil,
+	}))
+	ctx := shared.WithLogger(context.Background(), logger)
+
+	got, err := NewFileStandardLoader(tempDir).ListStandards(ctx)
+	if err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+
+	var names []string
+	for _, info := range got {
+		names = append(names, info.Name)
+	}
+	expected := []string{"a/b/two", "a/one", "root"}
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Errorf("FileStandardLoader.ListStandards() returned %v, expected %v", names, expected)
+	}
+
+	// Only the first directory beyond the limit is reported, its subdirectories are never visited
+	if !strings.Contains(logs.String(), "directory=a/b/c") || strings.Contains(logs.String(), "a/b/c/d") {
+		t.Errorf("logs = %q, expected a warning about a/b/c only", logs.String())
+	}
+}
+
+func TestFileStandardLoader_GetStandards_OutsideScan(t *testing.T) {
+	tempDir := t.TempDir()
+
+	t.Setenv("AGENT_STANDARDS_MCP_MAX_DEPTH", "1")
+
+	for _, name := range []string{"root.md", "a/one.md", "a/b/two.md"} {
+		path := filepath.Join(tempDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create test directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("---\ndescription: \"Standard\"\n---\nContent"), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		recursive string
+		standard  string
+		found     bool
+	}{
+		{"within depth", "true", "a/one", true},
+		{"too deep", "true", "a/b/two", false},
+		{"nested without recursion", "false", "a/one", false},
+		{"root without recursion", "false", "root", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AGENT_STANDARDS_MCP_RECURSIVE", tt.recursive)
+
+			standards, _, err := NewFileStandardLoader(tempDir).GetStandards(context.Background(), []string{tt.standard})
+			if err != nil {
+				t.Fatalf("FileStandardLoader.GetStandards() error = %v", err)
+			}
+			if found := len(standards) == 1; found != tt.found {
+				t.Errorf("FileStandardLoader.GetStandards(%q) found = %v, expected %v", tt.standard, found, tt.found)
+			}
+		})
+	}
+}
+
+func TestFileStandardLoader_BinaryFile(t *testing.T) {
+	tempDir := t.TempDir()
+
+	files := map[string][]byte{
+		"text.md":   []byte("---\ndescription: \"Text\"\n---\nContent"),
+		"binary.md": append([]byte("---\ndescription: \"Binary\"\n---\n"), 0x89, 'P', 'N', 'G', 0x00, 0x01),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), content, 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+		AddSource:   false,
+		Level:       slog.LevelWarn,
+		ReplaceAttr: nil,
+	}))
+	ctx := shared.WithLogger(context.Background(), logger)
+
+	loader := NewFileStandardLoader(tempDir)
+	infos, err := loader.ListStandards(ctx)
+	if err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+	if len(infos) != 1 || infos[0].Name != "text" {
+		t.Errorf("FileStandardLoader.ListStandards() = %+v, expected only the text standard", infos)
+	}
+	if !strings.Contains(logs.String(), "Skipping binary file") || !strings.Contains(logs.String(), "binary.md") {
+		t.Errorf("logs = %q, expected a warning about the binary file", logs.String())
+	}
+
+	// A binary file addressed directly by its name is not found either
+	if _, err := loader.GetStandard(ctx, "binary"); !errors.Is(err, domain.ErrStandardNotFound) {
+		t.Errorf("FileStandardLoader.GetStandard() error = %v, expected to wrap %v", err, domain.ErrStandardNotFound)
+	}
+}
+
+func TestFileStandardLoader_ListStandards_NotRecursiveByDefault(t *testing.T) {
+	tempDir := t.TempDir()
+
+	t.Setenv("AGENT_STANDARDS_MCP_RECURSIVE", "")
+
+	if err := os.MkdirAll(filepath.Join(tempDir, "nested"), 0755); err != nil {
+		t.Fatalf("Failed to create test directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "nested", "inner.md"), []byte("Inner content"), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "outer.md"), []byte("Outer content"), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	got, err := NewFileStandardLoader(tempDir).ListStandards(context.Background())
+	if err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "outer" {
+		t.Errorf("FileStandardLoader.ListStandards() returned %v, expected only the top-level standard", got)
+	}
+}
+
+func TestFileStandardLoader_NameStrategy(t *testing.T) {
+	files := map[string]string{
+		"multiple.dots.in.name.md": "---\ndescription: \"Multiple dots\"\ntitle: \"Dotted Names: A Guide!\"\n---\nDots content",
+		"python.testing.md":        "---\ndescription: \"Python testing\"\n---\nTesting content",
+	}
+
+	tests := []struct {
+		name     string
+		strategy string
+		expected map[string]string
+	}{
+		{
+			name:     "filename by default",
+			strategy: "",
+			expected: map[string]string{"multiple.dots.in.name": "Dots content", "python.testing": "Testing content"},
+		},
+		{
+			name:     "first dot",
+			strategy: "first-dot",
+			expected: map[string]string{"multiple": "Dots content", "python": "Testing content"},
+		},
+		{
+			name:     "title slug with filename fallback",
+			strategy: "title-slug",
+			expected: map[string]string{"dotted-names-a-guide": "Dots content", "python.testing": "Testing content"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+
+			t.Setenv("AGENT_STANDARDS_MCP_NAME_STRATEGY", tt.strategy)
+
+			for name, content := range files {
+				if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+					t.Fatalf("Failed to write test file: %v", err)
+				}
+			}
+
+			loader := NewFileStandardLoader(tempDir)
+			infos, err := loader.ListStandards(context.Background())
+			if err != nil {
+				t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+			}
+
+			names := make([]string, 0, len(infos))
+			for _, info := range infos {
+				if _, ok := tt.expected[info.Name]; !ok {
+					t.Errorf("unexpected standard name %q", info.Name)
+				}
+				names = append(names, info.Name)
+			}
+
+			// Listed names must resolve back to their content
+			standards, _, err := loader.GetStandards(context.Background(), names)
+			if err != nil {
+				t.Fatalf("FileStandardLoader.GetStandards() error = %v", err)
+			}
+			if len(standards) != len(tt.expected) {
+				t.Fatalf("FileStandardLoader.GetStandards() returned %d standards, expected %d", len(standards), len(tt.expected))
+			}
+			for _, standard := range standards {
+				if standard.Content != tt.expected[standard.Name] {
+					t.Errorf("standard %s has content %q, expected %q", standard.Name, standard.Content, tt.expected[standard.Name])
+				}
+			}
+		})
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		title    string
+		expected string
+	}{
+		{title: "Go Error Handling", expected: "go-error-handling"},
+		{title: "  C++ / Rust: FFI!  ", expected: "c-rust-ffi"},
+		{title: "", expected: ""},
+		{title: "---", expected: ""},
+	}
+
+	for _, tt := range tests {
+		if got := shared.Slugify(tt.title); got != tt.expected {
+			t.Errorf("shared.Slugify(%q) = %q, expected %q", tt.title, got, tt.expected)
+		}
+	}
+}
+
+func TestFrontmatterSchema(t *testing.T) {
+	schema := FrontmatterSchema()
+
+	if schema["type"] != "object" {
+		t.Errorf("FrontmatterSchema() type = %v, expected object", schema["type"])
+	}
+
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		t.Fatalf("FrontmatterSchema() properties has type %T, expected map", schema["properties"])
+	}
+
+	// Every known frontmatter field must be described
+	// Descriptions can be localized by a map of languages to strings
+	for field, expectedType := range map[string]string{"description": "[string object]", "title": "string"} {
+		property, ok := properties[field].(map[string]any)
+		if !ok {
+			t.Errorf("FrontmatterSchema() is missing field %q", field)
+			continue
+		}
+		if fmt.Sprint(property["type"]) != expectedType {
+			t.Errorf("field %q has type %v, expected %s", field, property["type"], expectedType)
+		}
+		if property["description"] == "" || property["description"] == nil {
+			t.Errorf("field %q has no description", field)
+		}
+	}
+
+	required, ok := schema["required"].([]string)
+	if !ok || len(required) != 1 || required[0] != "description" {
+		t.Errorf("FrontmatterSchema() required = %v, expected [description]", schema["required"])
+	}
+
+	// The constraints follow the checks of the parser
+	priority, _ := properties["priority"].(map[string]any)
+	if priority["minimum"] != minPriority || priority["maximum"] != maxPriority {
+		t.Errorf("field priority = %v, expected bounds %g..%g", priority, minPriority, maxPriority)
+	}
+	if reviewBy, _ := properties["review_by"].(map[string]any); reviewBy["anyOf"] == nil {
+		t.Errorf("field review_by = %v, expected date formats", reviewBy)
+	}
+
+	// An empty description is accepted when configured, so it is not required then
+	t.Setenv("AGENT_STANDARDS_MCP_EMPTY_DESCRIPTION_OK", "true")
+	if required, _ := FrontmatterSchema()["required"].([]string); len(required) != 0 {
+		t.Errorf("FrontmatterSchema() required = %v with empty descriptions allowed, expected none", required)
+	}
+}
+
+func TestFileStandardLoader_LanguageVariants(t *testing.T) {
+	t.Setenv("AGENT_STANDARDS_MCP_LANGUAGE_VARIANTS", "true")
+
+	tempDir := t.TempDir()
+
+	files := map[string]string{
+		"error-handling.en.md": "---\ndescription: \"Error handling\"\n---\nEnglish content",
+		"error-handling.ru.md": "---\ndescription: \"Обработка ошибок\"\n---\nRussian content",
+		"api.v2.md":            "---\ndescription: \"API v2\"\n---\nAPI content",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	loader := NewFileStandardLoader(tempDir)
+
+	infos, err := loader.ListStandards(context.Background())
+	if err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+
+	gotLanguages := make(map[string][]string)
+	for _, info := range infos {
+		gotLanguages[info.Name] = append(gotLanguages[info.Name], info.Language)
+	}
+	if len(gotLanguages["error-handling"]) != 2 {
+		t.Errorf("expected two language variants of error-handling, got %v", gotLanguages)
+	}
+	if languages := gotLanguages["api.v2"]; len(languages) != 1 || languages[0] != "" {
+		t.Errorf("expected api.v2 to be an untagged standard, got %v", gotLanguages)
+	}
+
+	// The logical name resolves to all variants
+	standards, _, err := loader.GetStandards(context.Background(), []string{"error-handling"})
+	if err != nil {
+		t.Fatalf("FileStandardLoader.GetStandards() error = %v", err)
+	}
+	contents := make(map[string]string, len(standards))
+	for _, standard := range standards {
+		if standard.Name != "error-handling" {
+			t.Errorf("variant has name %q, expected error-handling", standard.Name)
+		}
+		contents[standard.Language] = standard.Content
+	}
+	if contents["en"] != "English content" || contents["ru"] != "Russian content" {
+		t.Errorf("FileStandardLoader.GetStandards() returned variants %v", contents)
+	}
+}
+
+func TestFileStandardLoader_GetStandard(t *testing.T) {
+	t.Setenv("AGENT_STANDARDS_MCP_LANGUAGE_VARIANTS", "true")
+
+	tempDir := t.TempDir()
+
+	files := map[string]string{
+		"error-handling.md":    "---\ndescription: \"Error handling\"\n---\nDefault content",
+		"error-handling.ru.md": "---\ndescription: \"Error handling\"\n---\nRussian content",
+		"testing.de.md":        "---\ndescription: \"Testing\"\n---\nGerman content",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	loader := NewFileStandardLoader(tempDir)
+	ctx := context.Background()
+
+	tests := []struct {
+		name            string
+		standardName    string
+		expectedContent string
+		expectNotFound  bool
+	}{
+		{"untagged variant is preferred", "error-handling", "Default content", false},
+		{"tagged variant by file name", "error-handling.ru", "Russian content", false},
+		{"only tagged variant", "testing", "German content", false},
+		{"unknown name", "missing", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standard, err := loader.GetStandard(ctx, tt.standardName)
+			if tt.expectNotFound {
+				if !errors.Is(err, domain.ErrStandardNotFound) {
+					t.Errorf("FileStandardLoader.GetStandard() error = %v, expected domain.ErrStandardNotFound", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FileStandardLoader.GetStandard() error = %v", err)
+			}
+			if standard.Content != tt.expectedContent {
+				t.Errorf("FileStandardLoader.GetStandard() content = %q, expected %q", standard.Content, tt.expectedContent)
+			}
+		})
+	}
+}
+
+func TestFileStandardLoader_DebugLogsResolvedPath(t *testing.T) {
+	tempDir := t.TempDir()
+
+	content := "---\ndescription: \"Test\"\n---\nContent"
+	if err := os.WriteFile(filepath.Join(tempDir, "golang.txt"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+		AddSource:   false,
+		Level:       slog.LevelDebug,
+		ReplaceAttr: nil,
+	}))
+	ctx := shared.WithLogger(context.Background(), logger)
+
+	// An extension mismatch misses the file
+	standards, _, err := NewFileStandardLoader(tempDir).GetStandards(ctx, []string{"golang"})
+	if err != nil {
+		t.Fatalf("FileStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 0 {
+		t.Fatalf("FileStandardLoader.GetStandards() = %+v, expected no standards", standards)
+	}
+
+	got := logs.String()
+	for _, expected := range []string{
+		"level=DEBUG",
+		"standard=golang",
+		"file_path=" + filepath.Join(tempDir, "golang.md"),
+		"standards_dir=" + tempDir,
+		"valid=false",
+		"file does not exist",
+	} {
+		if !strings.Contains(got, expected) {
+			t.Errorf("debug log %q does not contain %q", got, expected)
+		}
+	}
+}
+
+func TestGetMaxStandardSize(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected int64
+		wantErr  bool
+	}{
+		{value: "", expected: oneMB},
+		{value: "2048", expected: 2048},
+		{value: "64KB", expected: 64 * 1024},
+		{value: "1MB", expected: oneMB},
+		{value: "large", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE", tt.value)
+
+			got, err := getMaxStandardSize()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getMaxStandardSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("getMaxStandardSize() = %d, expected %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFileStandardLoader_OversizedStandard(t *testing.T) {
+	tempDir := t.TempDir()
+
+	t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE", "64")
+
+	files := map[string]string{
+		"small.md": "---\ndescription: \"Small\"\n---\nContent",
+		"large.md": "---\ndescription: \"Large\"\n---\n" + strings.Repeat("x", 100),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	t.Run("strict", func(t *testing.T) {
+		t.Setenv("AGENT_STANDARDS_MCP_STRICT", "true")
+
+		_, _, err := NewFileStandardLoader(tempDir).GetStandards(context.Background(), []string{"large", "small"})
+		if !errors.Is(err, domain.ErrFileTooLarge) {
+			t.Errorf("FileStandardLoader.GetStandards() error = %v, expected to wrap %v", err, domain.ErrFileTooLarge)
+		}
+	})
+
+	t.Run("skipped", func(t *testing.T) {
+		t.Setenv("AGENT_STANDARDS_MCP_STRICT", "false")
+
+		var logs bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+			AddSource:   false,
+			Level:       slog.LevelWarn,
+			ReplaceAttr: nil,
+		}))
+		ctx := shared.WithLogger(context.Background(), logger)
+
+		standards, skipped, err := NewFileStandardLoader(tempDir).GetStandards(ctx, []string{"large", "small"})
+		if err != nil {
+			t.Fatalf("FileStandardLoader.GetStandards() error = %v", err)
+		}
+		if len(standards) != 1 || standards[0].Name != "small" {
+			t.Fatalf("FileStandardLoader.GetStandards() = %+v, expected only the small standard", standards)
+		}
+		if len(skipped) != 1 || skipped[0].Name != "large" {
+			t.Errorf("FileStandardLoader.GetStandards() skipped = %+v, expected the large standard", skipped)
+		}
+
+		got := logs.String()
+		for _, expected := range []string{"level=WARN", "standard=large", "exceeds maximum limit"} {
+			if !strings.Contains(got, expected) {
+				t.Errorf("warning %q does not contain %q", got, expected)
+			}
+		}
+	})
+
+	t.Run("listed", func(t *testing.T) {
+		t.Setenv("AGENT_STANDARDS_MCP_STRICT", "false")
+
+		var logs bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+			AddSource:   false,
+			Level:       slog.LevelWarn,
+			ReplaceAttr: nil,
+		}))
+		ctx := shared.WithLogger(context.Background(), logger)
+
+		infos, err := NewFileStandardLoader(tempDir).ListStandards(ctx)
+		if err != nil {
+			t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+		}
+		if len(infos) != 1 || infos[0].Name != "small" {
+			t.Fatalf("FileStandardLoader.ListStandards() = %+v, expected only the small standard", infos)
+		}
+		if got := logs.String(); !strings.Contains(got, "standard=large") {
+			t.Errorf("warning %q does not name the large standard", got)
+		}
+	})
+
+	t.Run("listed strict", func(t *testing.T) {
+		t.Setenv("AGENT_STANDARDS_MCP_STRICT", "true")
+
+		_, err := NewFileStandardLoader(tempDir).ListStandards(context.Background())
+		if !errors.Is(err, domain.ErrFileTooLarge) {
+			t.Errorf("FileStandardLoader.ListStandards() error = %v, expected to wrap %v", err, domain.ErrFileTooLarge)
+		}
+	})
+}
+
+func TestFileStandardLoader_NormalizeNewlines(t *testing.T) {
+	tempDir := t.TempDir()
+
+	content := "---\r\ndescription: \"Windows\"\r\n---\r\nFirst line\r\nSecond line\r\n"
+	if err := os.WriteFile(filepath.Join(tempDir, "windows.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		normalize string
+		raw       bool
+		expected  string
+	}{
+		{name: "normalized by default", normalize: "", raw: false, expected: "First line\nSecond line"},
+		{name: "normalization disabled", normalize: "false", raw: false, expected: "First line\r\nSecond line"},
+		{name: "raw content preserved", normalize: "true", raw: true, expected: "First line\r\nSecond line"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AGENT_STANDARDS_MCP_NORMALIZE_NEWLINES", tt.normalize)
+
+			ctx := context.Background()
+			if tt.raw {
+				ctx = shared.WithRawContent(ctx)
+			}
+
+			standard, err := NewFileStandardLoader(tempDir).GetStandard(ctx, "windows")
+			if err != nil {
+				t.Fatalf("FileStandardLoader.GetStandard() error = %v", err)
+			}
+			if standard.Description != "Windows" {
+				t.Errorf("FileStandardLoader.GetStandard() description = %q, expected %q", standard.Description, "Windows")
+			}
+			if standard.Content != tt.expected {
+				t.Errorf("FileStandardLoader.GetStandard() content = %q, expected %q", standard.Content, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSplitLanguage(t *testing.T) {
+	tests := []struct {
+		filePath         string
+		expectedPath     string
+		expectedLanguage string
+	}{
+		{filePath: "/std/errors.ru.md", expectedPath: "/std/errors.md", expectedLanguage: "ru"},
+		{filePath: "/std/errors.md", expectedPath: "/std/errors.md", expectedLanguage: ""},
+		{filePath: "/std/api.v2.md", expectedPath: "/std/api.v2.md", expectedLanguage: ""},
+		{filePath: "/std/multiple.dots.in.name.md", expectedPath: "/std/multiple.dots.in.name.md", expectedLanguage: ""},
+		{filePath: "/std/errors.EN.md", expectedPath: "/std/errors.EN.md", expectedLanguage: ""},
+		{filePath: "/std/en.md", expectedPath: "/std/en.md", expectedLanguage: ""},
+		{filePath: "/std/node.js.md", expectedPath: "/std/node.js.md", expectedLanguage: ""},
+		{filePath: "/std/style.go.md", expectedPath: "/std/style.go.md", expectedLanguage: ""},
+	}
+
+	t.Setenv("AGENT_STANDARDS_MCP_LANGUAGE_VARIANTS", "true")
+	for _, tt := range tests {
+		gotPath, gotLanguage := splitLanguage(tt.filePath)
+		if gotPath != tt.expectedPath || gotLanguage != tt.expectedLanguage {
+			t.Errorf("splitLanguage(%q) = (%q, %q), expected (%q, %q)",
+				tt.filePath, gotPath, gotLanguage, tt.expectedPath, tt.expectedLanguage)
+		}
+	}
+}
+
+func TestFileStandardLoader_LanguageTagSuffix(t *testing.T) {
+	tempDir := t.TempDir()
+
+	content := "---\ndescription: \"TypeScript rules\"\n---\nContent"
+	if err := os.WriteFile(filepath.Join(tempDir, "x.ts.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		languageVariants string
+		expectedName     string
+		expectedLanguage string
+	}{
+		// ts is the ISO 639-1 code of Tsonga, by default it stays part of the name
+		{name: "default", languageVariants: "", expectedName: "x.ts", expectedLanguage: ""},
+		{name: "language variants", languageVariants: "true", expectedName: "x", expectedLanguage: "ts"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AGENT_STANDARDS_MCP_LANGUAGE_VARIANTS", tt.languageVariants)
+
+			infos, err := NewFileStandardLoader(tempDir).ListStandards(context.Background())
+			if err != nil {
+				t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+			}
+			if len(infos) != 1 || infos[0].Name != tt.expectedName || infos[0].Language != tt.expectedLanguage {
+				t.Errorf("FileStandardLoader.ListStandards() = %+v, expected %s with language %q",
+					infos, tt.expectedName, tt.expectedLanguage)
+			}
+		})
+	}
+}
+
+func TestParseFrontmatterData_ReviewBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		reviewBy string
+		expected time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "no review date",
+			reviewBy: "",
+			expected: time.Time{},
+		},
+		{
+			name:     "date only",
+			reviewBy: "2025-03-01",
+			expected: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "RFC3339",
+			reviewBy: "2025-03-01T12:30:00+02:00",
+			expected: time.Date(2025, time.March, 1, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "quoted date",
+			reviewBy: "\"2025-03-01\"",
+			expected: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "malformed date",
+			reviewBy: "01.03.2025",
+			wantErr:  true,
+		},
+		{
+			name:     "impossible date",
+			reviewBy: "2025-02-30",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "---\ndescription: \"Test\"\n"
+			if tt.reviewBy != "" {
+				content += "review_by: " + tt.reviewBy + "\n"
+			}
+			content += "---\nContent"
+
+			fm, _, err := parseFrontmatterData(content)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseFrontmatterData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), "review_by") {
+					t.Errorf("parseFrontmatterData() error = %v, expected to mention review_by", err)
+				}
+				return
+			}
+			if !fm.reviewByDate.Equal(tt.expected) {
+				t.Errorf("parseFrontmatterData() review date = %v, expected %v", fm.reviewByDate, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFrontmatterData_Priority(t *testing.T) {
+	tests := []struct {
+		name     string
+		priority string
+		expected float64
+		wantErr  bool
+	}{
+		{name: "not set", priority: "", expected: 0},
+		{name: "lowest", priority: "0", expected: 0},
+		{name: "highest", priority: "1", expected: 1},
+		{name: "fraction", priority: "0.75", expected: 0.75},
+		{name: "above range", priority: "2", wantErr: true},
+		{name: "below range", priority: "-0.5", wantErr: true},
+		{name: "not a number", priority: "high", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "---\ndescription: \"Test\"\n"
+			if tt.priority != "" {
+				content += "priority: " + tt.priority + "\n"
+			}
+			content += "---\nContent"
+
+			fm, _, err := parseFrontmatterData(content)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseFrontmatterData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && fm.Priority != tt.expected {
+				t.Errorf("parseFrontmatterData() priority = %v, expected %v", fm.Priority, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFrontmatterData_When(t *testing.T) {
+	tests := []struct {
+		name     string
+		when     string
+		expected string
+		wantErr  bool
+	}{
+		{name: "not set", when: "", expected: ""},
+		{name: "comparison", when: "\"language == go\"", expected: "language == go"},
+		{name: "trimmed", when: "\" ci && language != python \"", expected: "ci && language != python"},
+		{name: "invalid expression", when: "\"language = go\"", wantErr: true},
+		{name: "unbalanced parentheses", when: "\"(ci\"", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "---\ndescription: \"Test\"\n"
+			if tt.when != "" {
+				content += "when: " + tt.when + "\n"
+			}
+			content += "---\nContent"
+
+			fm, _, err := parseFrontmatterData(content)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseFrontmatterData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && fm.When != tt.expected {
+				t.Errorf("parseFrontmatterData() when = %q, expected %q", fm.When, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFrontmatterData_Order(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    string
+		expected int
+		set      bool
+		wantErr  bool
+	}{
+		{name: "not set", order: ""},
+		{name: "positive", order: "3", expected: 3, set: true},
+		{name: "zero is set", order: "0", expected: 0, set: true},
+		{name: "negative", order: "-1", expected: -1, set: true},
+		{name: "not a number", order: "first", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "---\ndescription: \"Test\"\n"
+			if tt.order != "" {
+				content += "order: " + tt.order + "\n"
+			}
+			content += "---\nContent"
+
+			fm, _, err := parseFrontmatterData(content)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseFrontmatterData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if (fm.Order != nil) != tt.set {
+				t.Fatalf("parseFrontmatterData() order set = %v, expected %v", fm.Order != nil, tt.set)
+			}
+			if tt.set && *fm.Order != tt.expected {
+				t.Errorf("parseFrontmatterData() order = %d, expected %d", *fm.Order, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFrontmatterData_SHA256(t *testing.T) {
+	body := "## Errors\n\nWrap errors with context."
+	sum := sha256.Sum256([]byte(body))
+	checksum := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name     string
+		checksum string
+		body     string
+		wantErr  bool
+	}{
+		{name: "not set", checksum: "", body: body},
+		{name: "matching", checksum: checksum, body: body},
+		{name: "matching uppercase", checksum: strings.ToUpper(checksum), body: body},
+		{name: "surrounding whitespace ignored", checksum: checksum, body: "\n" + body + "\n\n"},
+		{name: "mismatching", checksum: checksum, body: body + " Tampered.", wantErr: true},
+		{name: "malformed", checksum: "not-a-checksum", body: body, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "---\ndescription: \"Test\"\n"
+			if tt.checksum != "" {
+				content += "sha256: " + tt.checksum + "\n"
+			}
+			content += "---\n" + tt.body
+
+			_, parsedContent, err := parseFrontmatterData(content)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseFrontmatterData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrChecksumMismatch) {
+					t.Errorf("parseFrontmatterData() error = %v, expected domain.ErrChecksumMismatch", err)
+				}
+				return
+			}
+			if parsedContent != body {
+				t.Errorf("parseFrontmatterData() content = %q, expected %q", parsedContent, body)
+			}
+		})
+	}
+}
+
+func TestParseStandard_TitleFromHeading(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		strip         bool
+		expectedTitle string
+		expectedBody  string
+	}{
+		{
+			name:          "first heading",
+			content:       "---\ndescription: Errors\n---\n# Error Handling\n\nWrap errors.\n\n# Logging\n",
+			expectedTitle: "Error Handling",
+			expectedBody:  "# Error Handling\n\nWrap errors.\n\n# Logging",
+		},
+		{
+			name:          "heading stripped",
+			content:       "---\ndescription: Errors\n---\n# Error Handling\n\nWrap errors.",
+			strip:         true,
+			expectedTitle: "Error Handling",
+			expectedBody:  "Wrap errors.",
+		},
+		{
+			name:          "closing hashes",
+			content:       "---\ndescription: Errors\n---\n# Error Handling ##\nWrap errors.",
+			expectedTitle: "Error Handling",
+			expectedBody:  "# Error Handling ##\nWrap errors.",
+		},
+		{
+			name:          "hash in title",
+			content:       "# Using C#\nRules.",
+			expectedTitle: "Using C#",
+			expectedBody:  "# Using C#\nRules.",
+		},
+		{
+			name:          "frontmatter title wins",
+			content:       "---\ndescription: Errors\ntitle: Errors\n---\n# Error Handling\nWrap errors.",
+			strip:         true,
+			expectedTitle: "Errors",
+			expectedBody:  "# Error Handling\nWrap errors.",
+		},
+		{
+			name:          "heading in code block ignored",
+			content:       "---\ndescription: Shell\n---\n```sh\n# comment\n```\n## Usage\n# Shell",
+			expectedTitle: "Shell",
+			expectedBody:  "```sh\n# comment\n```\n## Usage\n# Shell",
+		},
+		{
+			name:          "no heading",
+			content:       "---\ndescription: Errors\n---\n## Errors\nWrap errors.",
+			strip:         true,
+			expectedTitle: "",
+			expectedBody:  "## Errors\nWrap errors.",
+		},
+		{
+			name:          "heading only is kept",
+			content:       "---\ndescription: Errors\n---\n# Error Handling",
+			strip:         true,
+			expectedTitle: "Error Handling",
+			expectedBody:  "# Error Handling",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AGENT_STANDARDS_MCP_TITLE_FROM_HEADING", "true")
+			t.Setenv("AGENT_STANDARDS_MCP_STRIP_TITLE_HEADING", strconv.FormatBool(tt.strip))
+
+			parsed, err := parseStandard(tt.content)
+			if err != nil {
+				t.Fatalf("parseStandard() error = %v", err)
+			}
+			if parsed.fm.Title != tt.expectedTitle {
+				t.Errorf("parseStandard() title = %q, expected %q", parsed.fm.Title, tt.expectedTitle)
+			}
+			if parsed.body != tt.expectedBody {
+				t.Errorf("parseStandard() body = %q, expected %q", parsed.body, tt.expectedBody)
+			}
+		})
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		parsed, err := parseStandard("---\ndescription: Errors\n---\n# Error Handling\nWrap errors.")
+		if err != nil {
+			t.Fatalf("parseStandard() error = %v", err)
+		}
+		if parsed.fm.Title != "" {
+			t.Errorf("parseStandard() title = %q, expected none", parsed.fm.Title)
+		}
+	})
+}
+
+func TestParseFrontmatterData_LocalizedDescription(t *testing.T) {
+	tests := []struct {
+		name            string
+		description     string
+		defaultLanguage string
+		expected        string
+		expectedMap     map[string]string
+		wantErr         bool
+	}{
+		{
+			name:        "scalar",
+			description: "description: Error handling\n",
+			expected:    "Error handling",
+		},
+		{
+			name:            "map with default language",
+			description:     "description:\n  ru: Обработка ошибок\n  EN: Error handling\n",
+			defaultLanguage: "en",
+			expected:        "Error handling",
+			expectedMap:     map[string]string{"en": "Error handling", "ru": "Обработка ошибок"},
+		},
+		{
+			name:            "map selects configured default language",
+			description:     "description:\n  en: Error handling\n  ru: Обработка ошибок\n",
+			defaultLanguage: "ru",
+			expected:        "Обработка ошибок",
+			expectedMap:     map[string]string{"en": "Error handling", "ru": "Обработка ошибок"},
+		},
+		{
+			name:            "map without default language",
+			description:     "description:\n  ru: Обработка ошибок\n  de: Fehlerbehandlung\n",
+			defaultLanguage: "en",
+			expected:        "Fehlerbehandlung",
+			expectedMap:     map[string]string{"de": "Fehlerbehandlung", "ru": "Обработка ошибок"},
+		},
+		{
+			name:        "invalid language",
+			description: "description:\n  english: Error handling\n",
+			wantErr:     true,
+		},
+		{
+			name:        "empty translation",
+			description: "description:\n  en: \"\"\n",
+			wantErr:     true,
+		},
+		{
+			name:        "empty map",
+			description: "description: {}\n",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AGENT_STANDARDS_MCP_DEFAULT_LANGUAGE", tt.defaultLanguage)
+
+			fm, _, err := parseFrontmatterData("---\n" + tt.description + "---\nContent")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseFrontmatterData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if fm.description != tt.expected {
+				t.Errorf("parseFrontmatterData() description = %q, expected %q", fm.description, tt.expected)
+			}
+			if !maps.Equal(fm.descriptions, tt.expectedMap) {
+				t.Errorf("parseFrontmatterData() descriptions = %v, expected %v", fm.descriptions, tt.expectedMap)
+			}
+		})
+	}
+}
+
+func TestFileStandardLoader_Cancelled(t *testing.T) {
+	tempDir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(tempDir, "standard.md"), []byte("Content"), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	loader := NewFileStandardLoader(tempDir)
+
+	if _, err := loader.ListStandards(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("FileStandardLoader.ListStandards() error = %v, expected context.Canceled", err)
+	}
+	if _, _, err := loader.GetStandards(ctx, []string{"standard"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("FileStandardLoader.GetStandards() error = %v, expected context.Canceled", err)
+	}
+}
+
+func TestParseFrontmatterData_StrictYAML(t *testing.T) {
+	tests := []struct {
+		name        string
+		frontmatter string
+		errMsg      string
+	}{
+		{
+			name:        "duplicate description",
+			frontmatter: "description: \"First\"\ndescription: \"Second\"\n",
+			errMsg:      "already defined",
+		},
+		{
+			name:        "type mismatch",
+			frontmatter: "description: [\"not\", \"a\", \"string\"]\n",
+			errMsg:      "cannot unmarshal",
+		},
+		{
+			name:        "unknown field is allowed",
+			frontmatter: "description: \"Test\"\nowner: \"platform-team\"\n",
+			errMsg:      "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := parseFrontmatterData("---\n" + tt.frontmatter + "---\nContent")
+
+			if tt.errMsg == "" {
+				if err != nil {
+					t.Errorf("parseFrontmatterData() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("parseFrontmatterData() error = %v, expected to contain %q", err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestFileStandardLoader_DuplicateFrontmatterKey(t *testing.T) {
+	tempDir := t.TempDir()
+
+	content := "---\ndescription: \"First\"\ndescription: \"Second\"\n---\nContent"
+	if err := os.WriteFile(filepath.Join(tempDir, "duplicated.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	// The error must point the author to the broken file
+	_, err := NewFileStandardLoader(tempDir).ListStandards(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "duplicated.md") {
+		t.Errorf("FileStandardLoader.ListStandards() error = %v, expected to name duplicated.md", err)
+	}
+}
+
+func TestParseFrontmatterData_Enabled(t *testing.T) {
+	tests := []struct {
+		name         string
+		frontmatter  string
+		wantDisabled bool
+		wantErr      bool
+	}{
+		{"absent means enabled", "description: \"Test\"\n", false, false},
+		{"explicitly enabled", "description: \"Test\"\nenabled: true\n", false, false},
+		{"disabled", "description: \"Test\"\nenabled: false\n", true, false},
+		{"not a boolean", "description: \"Test\"\nenabled: maybe\n", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, _, err := parseFrontmatterData("---\n" + tt.frontmatter + "---\nContent")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseFrontmatterData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if fm.disabled() != tt.wantDisabled {
+				t.Errorf("parseFrontmatterData() disabled = %v, expected %v", fm.disabled(), tt.wantDisabled)
+			}
+		})
+	}
+}
+
+func TestFileStandardLoader_Tags(t *testing.T) {
+	tempDir := t.TempDir()
+
+	content := "---\ndescription: \"Test\"\ntags: [security, go]\n---\nContent"
+	if err := os.WriteFile(filepath.Join(tempDir, "standard.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	infos, err := NewFileStandardLoader(tempDir).ListStandards(context.Background())
+	if err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+	if len(infos) != 1 || len(infos[0].Tags) != 2 || infos[0].Tags[0] != "security" || infos[0].Tags[1] != "go" {
+		t.Errorf("FileStandardLoader.ListStandards() = %+v, expected tags [security go]", infos)
+	}
+}
+
+func TestFileStandardLoader_Disabled(t *testing.T) {
+	tempDir := t.TempDir()
+
+	files := map[string]string{
+		"draft.md":     "---\ndescription: \"Draft\"\nenabled: false\n---\nDraft content",
+		"published.md": "---\ndescription: \"Published\"\n---\nPublished content",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	loader := NewFileStandardLoader(tempDir)
+
+	// The loader reports disabled standards, filtering is up to the caller
+	infos, err := loader.ListStandards(context.Background())
+	if err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+	for _, info := range infos {
+		if info.Disabled != (info.Name == "draft") {
+			t.Errorf("FileStandardLoader.ListStandards() %s disabled = %v", info.Name, info.Disabled)
+		}
+	}
+
+	standards, _, err := loader.GetStandards(context.Background(), []string{"draft"})
+	if err != nil {
+		t.Fatalf("FileStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 1 || !standards[0].Disabled {
+		t.Errorf("FileStandardLoader.GetStandards() = %+v, expected a disabled draft", standards)
+	}
+}
+
+func TestFileStandardLoader_FolderDisappeared(t *testing.T) {
+	tempDir := filepath.Join(t.TempDir(), "standards")
+	if err := os.Mkdir(tempDir, 0755); err != nil {
+		t.Fatalf("Failed to create standards folder: %v", err)
+	}
+
+	content := "---\ndescription: \"Test\"\n---\nContent"
+	if err := os.WriteFile(filepath.Join(tempDir, "standard.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	loader := NewFileStandardLoader(tempDir)
+	ctx := context.Background()
+
+	if _, err := loader.ListStandards(ctx); err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+
+	if err := os.RemoveAll(tempDir); err != nil {
+		t.Fatalf("Failed to remove standards folder: %v", err)
+	}
+
+	_, err := loader.ListStandards(ctx)
+	if !errors.Is(err, domain.ErrFolderDisappeared) || !strings.Contains(err.Error(), tempDir) {
+		t.Errorf("FileStandardLoader.ListStandards() error = %v, expected domain.ErrFolderDisappeared with the path", err)
+	}
+	if _, _, err := loader.GetStandards(ctx, []string{"standard"}); !errors.Is(err, domain.ErrFolderDisappeared) {
+		t.Errorf("FileStandardLoader.GetStandards() error = %v, expected domain.ErrFolderDisappeared", err)
+	}
+
+	// A folder that never existed is still just empty
+	infos, err := NewFileStandardLoader(filepath.Join(t.TempDir(), "missing")).ListStandards(ctx)
+	if err != nil || len(infos) != 0 {
+		t.Errorf("FileStandardLoader.ListStandards() = %v, %v, expected no standards", infos, err)
+	}
+}
+
+func TestParseIgnoreRules(t *testing.T) {
+	rules, err := parseIgnoreRules([]byte("# Drafts\n\n*.draft.md\ndrafts/\n/templates/*.md\nREADME.md\n"))
+	if err != nil {
+		t.Fatalf("parseIgnoreRules() error = %v", err)
+	}
+
+	tests := []struct {
+		relPath  string
+		expected bool
+	}{
+		{"go.draft.md", true},
+		{"nested/go.draft.md", true},
+		{"drafts/go.md", true},
+		{"nested/drafts/go.md", true},
+		{"templates/standard.md", true},
+		{"nested/templates/standard.md", false},
+		{"README.md", true},
+		{"go.md", false},
+		{"# Drafts", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.relPath, func(t *testing.T) {
+			if got := rules.excludes(tt.relPath); got != tt.expected {
+				t.Errorf("ignoreRules.excludes(%q) = %v, expected %v", tt.relPath, got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := parseIgnoreRules([]byte("[invalid\n")); err == nil {
+		t.Error("parseIgnoreRules() expected an error for a malformed pattern")
+	}
+}
+
+func TestFileStandardLoader_IgnoreFile(t *testing.T) {
+	tempDir := t.TempDir()
+
+	t.Setenv("AGENT_STANDARDS_MCP_RECURSIVE", "true")
+
+	content := "---\ndescription: \"Test\"\n---\nContent"
+	files := map[string]string{
+		ignoreFileName:        "# Not standards\nREADME.md\n*.draft.md\ndrafts/\n",
+		"README.md":           content,
+		"golang.md":           content,
+		"golang.draft.md":     content,
+		"drafts/testing.md":   content,
+		"nested/api.md":       content,
+		"nested/api.draft.md": content,
+	}
+	for name, fileContent := range files {
+		filePath := filepath.Join(tempDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatalf("Failed to create test directory: %v", err)
+		}
+		if err := os.WriteFile(filePath, []byte(fileContent), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	loader := NewFileStandardLoader(tempDir)
+
+	infos, err := loader.ListStandards(context.Background())
+	if err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, info.Name)
+	}
+	if len(names) != 2 || names[0] != "golang" || names[1] != "nested/api" {
+		t.Errorf("FileStandardLoader.ListStandards() names = %v, expected [golang nested/api]", names)
+	}
+
+	// Ignored files cannot be addressed directly by file name either
+	standards, _, err := loader.GetStandards(context.Background(), []string{"README", "golang.draft", "drafts/testing"})
+	if err != nil {
+		t.Fatalf("FileStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 0 {
+		t.Errorf("FileStandardLoader.GetStandards() = %+v, expected ignored files to be skipped", standards)
+	}
+}
+
+func TestContentTransform(t *testing.T) {
+	tests := []struct {
+		name      string
+		transform contentTransform
+		content   string
+		expected  string
+	}{
+		{
+			name:      "disabled",
+			transform: contentTransform{normalizeNewlines: false, stripComments: false, demoteHeadings: false},
+			content:   "# Title\n<!-- note -->\nText",
+			expected:  "# Title\n<!-- note -->\nText",
+		},
+		{
+			name:      "strip comments",
+			transform: contentTransform{normalizeNewlines: false, stripComments: true, demoteHeadings: false},
+			content:   "<!-- header -->\nText <!-- inline --> end\n<!--\nmulti\nline\n-->",
+			expected:  "Text  end",
+		},
+		{
+			name:      "demote headings",
+			transform: contentTransform{normalizeNewlines: false, stripComments: false, demoteHeadings: true},
+			content:   "# Title\n## Section\n#hashtag\n```bash\n# comment\n```\n# Other",
+			expected:  "## Title\n## Section\n#hashtag\n```bash\n# comment\n```\n## Other",
+		},
+		{
+			name:      "normalize newlines",
+			transform: contentTransform{normalizeNewlines: true, stripComments: false, demoteHeadings: false},
+			content:   "Windows\r\nline\r\nold Mac\rend\n",
+			expected:  "Windows\nline\nold Mac\nend\n",
+		},
+		{
+			name:      "normalize newlines before demoting headings",
+			transform: contentTransform{normalizeNewlines: true, stripComments: false, demoteHeadings: true},
+			content:   "# Title\r\nText",
+			expected:  "## Title\nText",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.transform.apply(context.Background(), tt.content); got != tt.expected {
+				t.Errorf("contentTransform.apply() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFileStandardLoader_ContentTransform(t *testing.T) {
+	tempDir := t.TempDir()
+
+	t.Setenv("AGENT_STANDARDS_MCP_STRIP_HTML_COMMENTS", "true")
+	t.Setenv("AGENT_STANDARDS_MCP_DEMOTE_HEADINGS", "true")
+
+	content := "---\ndescription: \"Test\"\n---\n<!-- TODO: review -->\n# Rules\nContent"
+	if err := os.WriteFile(filepath.Join(tempDir, "standard.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	standards, _, err := NewFileStandardLoader(tempDir).GetStandards(context.Background(), []string{"standard"})
+	if err != nil {
+		t.Fatalf("FileStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 1 || standards[0].Content != "## Rules\nContent" {
+		t.Errorf("FileStandardLoader.GetStandards() = %+v, expected transformed content", standards)
+	}
+}
+
+func TestParseStandard(t *testing.T) {
+	tests := []struct {
+		name           string
+		content        string
+		description    string
+		body           string
+		rawFrontmatter string
+	}{
+		{
+			name:           "with frontmatter",
+			content:        "---\ndescription: Test\ntags: [go]\n---\n\n# Rules\nContent\n",
+			description:    "Test",
+			body:           "# Rules\nContent",
+			rawFrontmatter: "---\ndescription: Test\ntags: [go]\n---",
+		},
+		{
+			name:           "without frontmatter",
+			content:        "# Rules\nContent\n",
+			description:    "",
+			body:           "# Rules\nContent\n",
+			rawFrontmatter: "",
+		},
+		{
+			name:           "unclosed frontmatter",
+			content:        "---\ndescription: Test\nContent",
+			description:    "",
+			body:           "---\ndescription: Test\nContent",
+			rawFrontmatter: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := parseStandard(tt.content)
+			if err != nil {
+				t.Fatalf("parseStandard() error = %v", err)
+			}
+			if parsed.fm.description != tt.description {
+				t.Errorf("parseStandard() description = %q, expected %q", parsed.fm.description, tt.description)
+			}
+			if parsed.body != tt.body {
+				t.Errorf("parseStandard() body = %q, expected %q", parsed.body, tt.body)
+			}
+			if parsed.rawFrontmatter != tt.rawFrontmatter {
+				t.Errorf("parseStandard() rawFrontmatter = %q, expected %q", parsed.rawFrontmatter, tt.rawFrontmatter)
+			}
+			if parsed.fullContent != tt.content {
+				t.Errorf("parseStandard() fullContent = %q, expected %q", parsed.fullContent, tt.content)
+			}
+		})
+	}
+}
+
+func TestContentTransform_Serve(t *testing.T) {
+	content := "---\r\ndescription: Test\r\n---\r\n# Rules\r\nContent"
+	parsed, err := parseStandard(content)
+	if err != nil {
+		t.Fatalf("parseStandard() error = %v", err)
+	}
+
+	transform := contentTransform{normalizeNewlines: true, stripComments: false, demoteHeadings: true}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{
+			name:     "body only",
+			ctx:      context.Background(),
+			expected: "## Rules\nContent",
+		},
+		{
+			name:     "raw body only",
+			ctx:      shared.WithRawContent(context.Background()),
+			expected: "# Rules\r\nContent",
+		},
+		{
+			name:     "with frontmatter",
+			ctx:      shared.WithFrontmatter(context.Background()),
+			expected: "---\r\ndescription: Test\r\n---\r\n\n## Rules\nContent",
+		},
+		{
+			name:     "raw with frontmatter",
+			ctx:      shared.WithFrontmatter(shared.WithRawContent(context.Background())),
+			expected: content,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := transform.serve(tt.ctx, parsed); got != tt.expected {
+				t.Errorf("contentTransform.serve() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFileStandardLoader_Frontmatter(t *testing.T) {
+	tempDir := t.TempDir()
+
+	content := "---\ndescription: \"Test\"\n---\n# Rules\nContent"
+	if err := os.WriteFile(filepath.Join(tempDir, "standard.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	loader := NewFileStandardLoader(tempDir)
+
+	infos, err := loader.ListStandards(context.Background())
+	if err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+	if len(infos) != 1 || infos[0].Description != "Test" {
+		t.Errorf("FileStandardLoader.ListStandards() = %+v, expected the parsed description", infos)
+	}
+
+	standards, _, err := loader.GetStandards(shared.WithFrontmatter(context.Background()), []string{"standard"})
+	if err != nil {
+		t.Fatalf("FileStandardLoader.GetStandards() error = %v", err)
+	}
+	expected := "---\ndescription: \"Test\"\n---\n\n# Rules\nContent"
+	if len(standards) != 1 || standards[0].Content != expected || standards[0].Description != "Test" {
+		t.Errorf("FileStandardLoader.GetStandards() = %+v, expected content with frontmatter", standards)
+	}
+}
+
+func TestFileStandardLoader_GetStandardByPath(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("AGENT_STANDARDS_MCP_RECURSIVE", "true")
+
+	files := map[string]string{
+		"standards/go/testing.md": "---\ndescription: \"Go testing\"\n---\nUse table tests",
+		"secret.md":               "---\ndescription: \"Outside\"\n---\nSecret",
+	}
+	for name, content := range files {
+		filePath := filepath.Join(tempDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatalf("Failed to create test directory: %v", err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	loader := NewFileStandardLoader(filepath.Join(tempDir, "standards"))
+
+	standard, err := loader.GetStandardByPath(context.Background(), "go/testing.md")
+	if err != nil {
+		t.Fatalf("FileStandardLoader.GetStandardByPath() error = %v", err)
+	}
+	if standard.Name != "go/testing" || standard.Description != "Go testing" || standard.Content != "Use table tests" {
+		t.Errorf("FileStandardLoader.GetStandardByPath() = %+v, expected the go/testing standard", standard)
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected error
+	}{
+		{name: "traversal", path: "../secret.md", expected: domain.ErrPathTraversal},
+		{name: "nested traversal", path: "go/../../secret.md", expected: domain.ErrPathTraversal},
+		{name: "absolute path", path: filepath.Join(tempDir, "secret.md"), expected: domain.ErrPathTraversal},
+		{name: "non-existent path", path: "go/missing.md", expected: domain.ErrStandardNotFound},
+		{name: "not a markdown file", path: "go/testing.txt", expected: domain.ErrStandardNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loader.GetStandardByPath(context.Background(), tt.path)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("FileStandardLoader.GetStandardByPath(%q) error = %v, expected %v", tt.path, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFileStandardLoader_CacheTTL(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("AGENT_STANDARDS_MCP_CACHE_TTL", "1h")
+
+	writeStandard := func(name string) {
+		t.Helper()
+		content := "---\ndescription: \"" + name + "\"\n---\nContent"
+		if err := os.WriteFile(filepath.Join(tempDir, name+".md"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+	listNames := func(loader *FileStandardLoader) []string {
+		t.Helper()
+		infos, err := loader.ListStandards(context.Background())
+		if err != nil {
+			t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+		}
+		names := make([]string, 0, len(infos))
+		for _, info := range infos {
+			names = append(names, info.Name)
+		}
+		slices.Sort(names)
+		return names
+	}
+
+	writeStandard("first")
+	loader := NewFileStandardLoader(tempDir)
+	if names := listNames(loader); !slices.Equal(names, []string{"first"}) {
+		t.Fatalf("ListStandards() = %v, expected [first]", names)
+	}
+
+	// Files added within the TTL are not seen until the cached scan expires
+	writeStandard("second")
+	if names := listNames(loader); !slices.Equal(names, []string{"first"}) {
+		t.Errorf("ListStandards() = %v, expected the cached scan [first]", names)
+	}
+
+	loader.scans.entries[loader.scanKey] = cachedScan{
+		files:     loader.scans.entries[loader.scanKey].files,
+		scannedAt: time.Now().Add(-2 * time.Hour),
+	}
+	if names := listNames(loader); !slices.Equal(names, []string{"first", "second"}) {
+		t.Errorf("ListStandards() = %v, expected a rescan after the TTL expired", names)
+	}
+
+	// Files deleted within the TTL trigger a rescan instead of failing the listing
+	if err := os.Remove(filepath.Join(tempDir, "first.md")); err != nil {
+		t.Fatalf("Failed to remove test file: %v", err)
+	}
+	if names := listNames(loader); !slices.Equal(names, []string{"second"}) {
+		t.Errorf("ListStandards() = %v, expected a rescan after a file was deleted", names)
+	}
+}
+
+func TestFileStandardLoader_CacheTTL_TitleSlugIndex(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("AGENT_STANDARDS_MCP_CACHE_TTL", "1h")
+	t.Setenv("AGENT_STANDARDS_MCP_NAME_STRATEGY", "title-slug")
+
+	for name, title := range map[string]string{"first": "First Rules", "second": "Second Rules"} {
+		content := "---\ntitle: \"" + title + "\"\ndescription: \"" + name + "\"\n---\nContent of " + name
+		if err := os.WriteFile(filepath.Join(tempDir, name+".md"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	loader := NewFileStandardLoader(tempDir)
+	if _, err := loader.ListStandards(context.Background()); err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+
+	// A file deleted after the cached scan triggers a rescan instead of failing the index
+	if err := os.Remove(filepath.Join(tempDir, "first.md")); err != nil {
+		t.Fatalf("Failed to remove test file: %v", err)
+	}
+	standards, _, err := loader.GetStandards(context.Background(), []string{"first-rules", "second-rules"})
+	if err != nil {
+		t.Fatalf("FileStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 1 || standards[0].Name != "second-rules" {
+		t.Errorf("FileStandardLoader.GetStandards() = %+v, expected only second-rules", standards)
+	}
+}
+
+func TestFileStandardLoader_NoCacheByDefault(t *testing.T) {
+	tempDir := t.TempDir()
+
+	loader := NewFileStandardLoader(tempDir)
+	if _, err := loader.ListStandards(context.Background()); err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+
+	content := "---\ndescription: \"Added\"\n---\nContent"
+	if err := os.WriteFile(filepath.Join(tempDir, "added.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	infos, err := loader.ListStandards(context.Background())
+	if err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+	if len(infos) != 1 {
+		t.Errorf("FileStandardLoader.ListStandards() = %+v, expected the added standard without a cache", infos)
+	}
+}
+
+func TestFileStandardLoader_RelativeFolder(t *testing.T) {
+	workDir := t.TempDir()
+	t.Chdir(workDir)
+	if err := os.Mkdir("standards", 0755); err != nil {
+		t.Fatalf("Failed to create standards folder: %v", err)
+	}
+	content := "---\ndescription: \"Go rules\"\n---\nContent"
+	if err := os.WriteFile(filepath.Join("standards", "go.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	// The loader scans the folder resolved by the configuration
+	folder, err := config.ResolveFolder("./standards")
+	if err != nil {
+		t.Fatalf("config.ResolveFolder() error = %v", err)
+	}
+	if folder != filepath.Join(workDir, "standards") {
+		t.Errorf("config.ResolveFolder() = %q, expected %q", folder, filepath.Join(workDir, "standards"))
+	}
+
+	loader := NewFileStandardLoader(folder)
+
+	infos, err := loader.ListStandards(context.Background())
+	if err != nil {
+		t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+	}
+	if len(infos) != 1 || infos[0].Path != "go.md" {
+		t.Errorf("FileStandardLoader.ListStandards() = %+v, expected go.md", infos)
+	}
+	if _, err := loader.GetStandardByPath(context.Background(), "../standards/go.md"); !errors.Is(err, domain.ErrPathTraversal) {
+		t.Errorf("FileStandardLoader.GetStandardByPath() error = %v, expected domain.ErrPathTraversal", err)
+	}
+}
+
+func TestFileStandardLoader_SharedScanCache(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("AGENT_STANDARDS_MCP_CACHE_TTL", "1h")
+
+	writeStandard := func(name string) {
+		t.Helper()
+		content := "---\ndescription: \"" + name + "\"\n---\nContent"
+		if err := os.WriteFile(filepath.Join(tempDir, name+".md"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+	countStandards := func(loader *FileStandardLoader) int {
+		t.Helper()
+		infos, err := loader.ListStandards(context.Background())
+		if err != nil {
+			t.Fatalf("FileStandardLoader.ListStandards() error = %v", err)
+		}
+		return len(infos)
+	}
+
+	writeStandard("first")
+
+	// Loaders have their own cache by default, so each scans the folder
+	first := NewFileStandardLoader(tempDir)
+	countStandards(first)
+	writeStandard("second")
+	if count := countStandards(NewFileStandardLoader(tempDir)); count != 2 {
+		t.Errorf("ListStandards() of a second loader = %d standards, expected its own scan of 2", count)
+	}
+
+	// With the shared cache, the scan of the first loader is reused by the second one
+	t.Setenv("AGENT_STANDARDS_MCP_SHARED_SCAN_CACHE", "true")
+	sharedFirst := NewFileStandardLoader(tempDir)
+	sharedSecond := NewFileStandardLoader(tempDir)
+	if count := countStandards(sharedFirst); count != 2 {
+		t.Fatalf("ListStandards() = %d standards, expected 2", count)
+	}
+	writeStandard("third")
+	if count := countStandards(sharedSecond); count != 2 {
+		t.Errorf("ListStandards() of a second loader = %d standards, expected the shared scan of 2", count)
+	}
+
+	// Resetting the scan of one loader makes all loaders of the folder scan again
+	sharedFirst.resetScan()
+	if count := countStandards(sharedSecond); count != 3 {
+		t.Errorf("ListStandards() after a reset = %d standards, expected a rescan of 3", count)
+	}
+}
+
+// newStandardsHTTPServer serves the given documents by path and counts the requests per path.
+func newStandardsHTTPServer(t *testing.T, documents map[string]string) (*httptest.Server, map[string]*atomic.Int32) {
+	t.Helper()
+
+	requests := make(map[string]*atomic.Int32, len(documents))
+	for path := range documents {
+		requests[path] = &atomic.Int32{}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		document, ok := documents[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		requests[r.URL.Path].Add(1)
+		_, _ = w.Write([]byte(document))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
+
+// encryptStandard seals content with AES-GCM in the format of encrypted standard files: the nonce, then the sealed content.
+func encryptStandard(t *testing.T, key []byte, content string) []byte {
+	t.Helper()
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatalf("aes.NewCipher() error = %v", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatalf("cipher.NewGCM() error = %v", err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		t.Fatalf("rand.Read() error = %v", err)
+	}
+
+	return gcm.Seal(nonce, nonce, []byte(content), nil)
+}
+
+func TestFileStandardLoader_Encrypted(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	content := "---\ndescription: \"Secret rules\"\n---\nKeep secrets out of logs."
+
+	newLoader := func(t *testing.T, decryptKey string) *FileStandardLoader {
+		t.Helper()
+		tempDir := t.TempDir()
+		t.Setenv("AGENT_STANDARDS_MCP_DECRYPT_KEY", decryptKey)
+		if err := os.WriteFile(filepath.Join(tempDir, "secrets.md.enc"), encryptStandard(t, key, content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+		return NewFileStandardLoader(tempDir)
+	}
+
+	t.Run("correct key", func(t *testing.T) {
+		loader := newLoader(t, base64.StdEncoding.EncodeToString(key))
+
+		infos, err := loader.ListStandards(context.Background())
+		if err != nil {
+			t.Fatalf("ListStandards() error = %v", err)
+		}
+		if len(infos) != 1 || infos[0].Name != "secrets" || infos[0].Description != "Secret rules" {
+			t.Fatalf("ListStandards() = %+v, expected the decrypted secrets standard", infos)
+		}
+
+		standard, err := loader.GetStandard(context.Background(), "secrets")
+		if err != nil {
+			t.Fatalf("GetStandard() error = %v", err)
+		}
+		if standard.Content != "Keep secrets out of logs." {
+			t.Errorf("GetStandard() content = %q, expected the decrypted content", standard.Content)
+		}
+
+		standard, err = loader.GetStandardByPath(context.Background(), "secrets.md.enc")
+		if err != nil || standard.Name != "secrets" {
+			t.Errorf("GetStandardByPath() = %q, %v, expected the secrets standard", standard.Name, err)
+		}
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		loader := newLoader(t, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{8}, 32)))
+
+		if _, err := loader.ListStandards(context.Background()); !errors.Is(err, domain.ErrDecryptionFailed) {
+			t.Errorf("ListStandards() error = %v, expected domain.ErrDecryptionFailed", err)
+		}
+		if _, err := loader.GetStandard(context.Background(), "secrets"); !errors.Is(err, domain.ErrDecryptionFailed) {
+			t.Errorf("GetStandard() error = %v, expected domain.ErrDecryptionFailed", err)
+		}
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		loader := newLoader(t, "")
+
+		if _, err := loader.ListStandards(context.Background()); !errors.Is(err, domain.ErrDecryptKeyMissing) {
+			t.Errorf("ListStandards() error = %v, expected domain.ErrDecryptKeyMissing", err)
+		}
+	})
+
+	t.Run("size limit applies to decrypted content", func(t *testing.T) {
+		loader := newLoader(t, base64.StdEncoding.EncodeToString(key))
+		t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE", strconv.Itoa(len(content)))
+
+		if _, err := loader.ListStandards(context.Background()); err != nil {
+			t.Errorf("ListStandards() error = %v, expected the standard within the limit", err)
+		}
+
+		t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE", strconv.Itoa(len(content)-1))
+		if _, err := loader.ListStandards(context.Background()); !errors.Is(err, domain.ErrFileTooLarge) {
+			t.Errorf("ListStandards() error = %v, expected domain.ErrFileTooLarge", err)
+		}
+	})
+}
+
+func TestHTTPStandardLoader(t *testing.T) {
+	server, requests := newStandardsHTTPServer(t, map[string]string{
+		"/team/index.json": `{"standards": [
+			{"name": "go-errors", "description": "Go error handling", "path": "go/errors.md", "review_by": "2030-01-01"},
+			{"name": "go-errors", "description": "Go error handling", "path": "go/errors.ru.md", "language": "ru"},
+			{"name": "testing", "description": "Testing", "path": "testing.md"}
+		]}`,
+		"/team/go/errors.md":    "---\ndescription: \"Go error handling\"\npriority: 0.8\n---\nWrap errors.",
+		"/team/go/errors.ru.md": "---\ndescription: \"Go error handling\"\n---\nOborachivaite oshibki.",
+		"/team/testing.md":      "---\ndescription: \"Testing\"\n---\nWrite tests.",
+	})
+
+	loader, err := NewHTTPStandardLoader(server.URL + "/team")
+	if err != nil {
+		t.Fatalf("NewHTTPStandardLoader() error = %v", err)
+	}
+
+	ctx := context.Background()
+
+	infos, err := loader.ListStandards(ctx)
+	if err != nil {
+		t.Fatalf("HTTPStandardLoader.ListStandards() error = %v", err)
+	}
+	if len(infos) != 3 {
+		t.Fatalf("HTTPStandardLoader.ListStandards() returned %d standards, expected 3", len(infos))
+	}
+	if infos[0].Name != "go-errors" || infos[0].Path != "go/errors.md" || infos[0].ReviewBy.Year() != 2030 {
+		t.Errorf("HTTPStandardLoader.ListStandards()[0] = %+v, expected go-errors from the index", infos[0])
+	}
+	if infos[1].Language != "ru" {
+		t.Errorf("HTTPStandardLoader.ListStandards()[1].Language = %q, expected ru", infos[1].Language)
+	}
+
+	standards, _, err := loader.GetStandards(ctx, []string{"go-errors", "missing"})
+	if err != nil {
+		t.Fatalf("HTTPStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 2 {
+		t.Fatalf("HTTPStandardLoader.GetStandards() returned %d standards, expected 2", len(standards))
+	}
+	if standards[0].Content != "Wrap errors." || standards[0].Priority != 0.8 {
+		t.Errorf("HTTPStandardLoader.GetStandards()[0] = %+v, expected the fetched content", standards[0])
+	}
+	if standards[1].Language != "ru" {
+		t.Errorf("HTTPStandardLoader.GetStandards()[1].Language = %q, expected ru", standards[1].Language)
+	}
+
+	standard, err := loader.GetStandard(ctx, "go-errors")
+	if err != nil {
+		t.Fatalf("HTTPStandardLoader.GetStandard() error = %v", err)
+	}
+	if standard.Language != "" || standard.Content != "Wrap errors." {
+		t.Errorf("HTTPStandardLoader.GetStandard() = %+v, expected the untagged variant", standard)
+	}
+	if _, err := loader.GetStandard(ctx, "missing"); !errors.Is(err, domain.ErrStandardNotFound) {
+		t.Errorf("HTTPStandardLoader.GetStandard() error = %v, expected domain.ErrStandardNotFound", err)
+	}
+
+	// Repeated requests are served from the cache
+	if _, _, err := loader.GetStandards(ctx, []string{"go-errors"}); err != nil {
+		t.Fatalf("HTTPStandardLoader.GetStandards() error = %v", err)
+	}
+	if got := requests["/team/index.json"].Load(); got != 1 {
+		t.Errorf("index.json requested %d times, expected 1", got)
+	}
+	if got := requests["/team/go/errors.md"].Load(); got != 1 {
+		t.Errorf("go/errors.md requested %d times, expected 1", got)
+	}
+
+	// Expired entries are fetched again
+	loader.cacheTTL = 0
+	if _, err := loader.ListStandards(ctx); err != nil {
+		t.Fatalf("HTTPStandardLoader.ListStandards() error = %v", err)
+	}
+	if _, err := loader.ListStandards(ctx); err != nil {
+		t.Fatalf("HTTPStandardLoader.ListStandards() error = %v", err)
+	}
+	if got := requests["/team/index.json"].Load(); got != 3 {
+		t.Errorf("index.json requested %d times, expected 3", got)
+	}
+
+	// Every request to the server is a cache miss
+	var fetched int64
+	for _, count := range requests {
+		fetched += int64(count.Load())
+	}
+	if stats := loader.CacheStats(); stats.Misses != fetched || stats.Hits == 0 || stats.Entries == 0 {
+		t.Errorf("HTTPStandardLoader.CacheStats() = %+v, expected %d misses, some hits and entries", stats, fetched)
+	}
+}
+
+func TestHTTPStandardLoader_IndexMetadata(t *testing.T) {
+	server, _ := newStandardsHTTPServer(t, map[string]string{
+		"/index.json": `{"standards": [
+			{"name": "go", "description": "From the index", "path": "go.md", "tags": ["go"], "when": "language == go"}
+		]}`,
+		"/go.md": "---\ndescription: \"From the file\"\nenabled: false\ntags: [other]\npriority: 0.5\n---\nContent.",
+	})
+
+	loader, err := NewHTTPStandardLoader(server.URL)
+	if err != nil {
+		t.Fatalf("NewHTTPStandardLoader() error = %v", err)
+	}
+
+	// The fetched standard has the metadata listed in the index, the frontmatter provides the rest
+	standard, err := loader.GetStandard(context.Background(), "go")
+	if err != nil {
+		t.Fatalf("HTTPStandardLoader.GetStandard() error = %v", err)
+	}
+	if standard.Description != "From the index" || standard.Disabled || !slices.Equal(standard.Tags, []string{"go"}) ||
+		standard.When != "language == go" || standard.Priority != 0.5 {
+		t.Errorf("HTTPStandardLoader.GetStandard() = %+v, expected the metadata of the index", standard)
+	}
+}
+
+func TestHTTPStandardLoader_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		documents map[string]string
+		errMsg    string
+		errIs     error
+	}{
+		{
+			name:      "missing index",
+			documents: map[string]string{},
+			errMsg:    "404",
+			errIs:     nil,
+		},
+		{
+			name:      "invalid index",
+			documents: map[string]string{"/index.json": "not json"},
+			errMsg:    "failed to decode standards index",
+			errIs:     nil,
+		},
+		{
+			name:      "entry without path",
+			documents: map[string]string{"/index.json": `{"standards": [{"name": "standard"}]}`},
+			errMsg:    "must have a name and a path",
+			errIs:     nil,
+		},
+		{
+			name: "path traversal",
+			documents: map[string]string{
+				"/index.json": `{"standards": [{"name": "standard", "path": "http://example.com/standard.md"}]}`,
+			},
+			errMsg: "path traversal detected",
+			errIs:  domain.ErrPathTraversal,
+		},
+		{
+			name: "oversized standard",
+			documents: map[string]string{
+				"/index.json":  `{"standards": [{"name": "standard", "path": "standard.md"}]}`,
+				"/standard.md": "---\ndescription: \"Test\"\n---\n" + strings.Repeat("x", 100),
+			},
+			errMsg: "standard.md is 128 B (128 bytes)",
+			errIs:  domain.ErrFileTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := newStandardsHTTPServer(t, tt.documents)
+
+			t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE", "64")
+
+			loader, err := NewHTTPStandardLoader(server.URL)
+			if err != nil {
+				t.Fatalf("NewHTTPStandardLoader() error = %v", err)
+			}
+
+			_, _, err = loader.GetStandards(context.Background(), []string{"standard"})
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("HTTPStandardLoader.GetStandards() error = %v, expected to contain %q", err, tt.errMsg)
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("HTTPStandardLoader.GetStandards() error = %v, expected to wrap %v", err, tt.errIs)
+			}
+		})
+	}
+}
+
+func TestHTTPStandardLoader_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		<-release
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	loader, err := NewHTTPStandardLoader(server.URL)
+	if err != nil {
+		t.Fatalf("NewHTTPStandardLoader() error = %v", err)
+	}
+	loader.client.Timeout = 50 * time.Millisecond
+
+	if _, err := loader.ListStandards(context.Background()); err == nil {
+		t.Error("HTTPStandardLoader.ListStandards() expected a timeout error")
+	}
+}
+
+// writeZipArchive writes a zip archive with the given files to the test directory and returns its path.
+func writeZipArchive(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	archivePath := filepath.Join(t.TempDir(), "standards.zip")
+	file, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	writer := zip.NewWriter(file)
+	for name, content := range files {
+		entry, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("Failed to create archive entry %s: %v", name, err)
+		}
+		if _, err := entry.Write([]byte(content)); err != nil {
+			t.Fatalf("Failed to write archive entry %s: %v", name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close archive: %v", err)
+	}
+
+	return archivePath
+}
+
+func TestArchiveStandardLoader(t *testing.T) {
+	t.Setenv("AGENT_STANDARDS_MCP_LANGUAGE_VARIANTS", "true")
+
+	archivePath := writeZipArchive(t, map[string]string{
+		"go-errors.md":    "---\ndescription: \"Go error handling\"\npriority: 0.8\n---\nWrap errors.",
+		"go-errors.ru.md": "---\ndescription: \"Go error handling\"\n---\nOborachivaite oshibki.",
+		"nested/style.md": "---\ndescription: \"Style\"\n---\nFormat code.",
+		".hidden.md":      "---\ndescription: \"Hidden\"\n---\nHidden.",
+		"notes.txt":       "Not a standard.",
+	})
+
+	loader, err := NewArchiveStandardLoader(archivePath)
+	if err != nil {
+		t.Fatalf("NewArchiveStandardLoader() error = %v", err)
+	}
+
+	ctx := context.Background()
+
+	infos, err := loader.ListStandards(ctx)
+	if err != nil {
+		t.Fatalf("ArchiveStandardLoader.ListStandards() error = %v", err)
+	}
+	if len(infos) != 2 {
+		t.Fatalf("ArchiveStandardLoader.ListStandards() returned %d standards, expected 2: %+v", len(infos), infos)
+	}
+	for _, info := range infos {
+		if info.Name != "go-errors" || info.Description != "Go error handling" {
+			t.Errorf("ArchiveStandardLoader.ListStandards() = %+v, expected go-errors variants", info)
+		}
+	}
+
+	standards, _, err := loader.GetStandards(ctx, []string{"go-errors", "missing"})
+	if err != nil {
+		t.Fatalf("ArchiveStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 2 {
+		t.Fatalf("ArchiveStandardLoader.GetStandards() returned %d standards, expected 2", len(standards))
+	}
+
+	standard, err := loader.GetStandard(ctx, "go-errors")
+	if err != nil {
+		t.Fatalf("ArchiveStandardLoader.GetStandard() error = %v", err)
+	}
+	if standard.Language != "" || standard.Content != "Wrap errors." {
+		t.Errorf("ArchiveStandardLoader.GetStandard() = %+v, expected the untagged variant", standard)
+	}
+	if _, err := loader.GetStandard(ctx, "missing"); !errors.Is(err, domain.ErrStandardNotFound) {
+		t.Errorf("ArchiveStandardLoader.GetStandard() error = %v, expected domain.ErrStandardNotFound", err)
+	}
+	for _, standard := range standards {
+		if standard.Language == "" && (standard.Content != "Wrap errors." || standard.Priority != 0.8) {
+			t.Errorf("ArchiveStandardLoader.GetStandards() = %+v, expected the archived content", standard)
+		}
+	}
+
+	// Nested entries are only read recursively
+	t.Setenv("AGENT_STANDARDS_MCP_RECURSIVE", "true")
+	loader, err = NewArchiveStandardLoader(archivePath)
+	if err != nil {
+		t.Fatalf("NewArchiveStandardLoader() error = %v", err)
+	}
+
+	standards, _, err = loader.GetStandards(ctx, []string{"nested/style"})
+	if err != nil {
+		t.Fatalf("ArchiveStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 1 || standards[0].Content != "Format code." {
+		t.Errorf("ArchiveStandardLoader.GetStandards() = %+v, expected nested/style", standards)
+	}
+}
+
+func TestArchiveStandardLoader_Tar(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "standards.tar")
+	file, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+
+	content := "---\ndescription: \"Testing\"\n---\nWrite tests."
+	writer := tar.NewWriter(file)
+	if err := writer.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     "testing.md",
+		Size:     int64(len(content)),
+		Mode:     0o644,
+	}); err != nil {
+		t.Fatalf("Failed to write archive header: %v", err)
+	}
+	if _, err := writer.Write([]byte(content)); err != nil {
+		t.Fatalf("Failed to write archive entry: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close archive: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatalf("Failed to close archive file: %v", err)
+	}
+
+	loader, err := NewArchiveStandardLoader(archivePath)
+	if err != nil {
+		t.Fatalf("NewArchiveStandardLoader() error = %v", err)
+	}
+
+	standards, _, err := loader.GetStandards(context.Background(), []string{"testing"})
+	if err != nil {
+		t.Fatalf("ArchiveStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 1 || standards[0].Content != "Write tests." {
+		t.Errorf("ArchiveStandardLoader.GetStandards() = %+v, expected testing", standards)
+	}
+}
+
+func TestArchiveStandardLoader_Errors(t *testing.T) {
+	valid := "---\ndescription: \"Valid\"\n---\nContent."
+
+	tests := []struct {
+		name   string
+		files  map[string]string
+		errMsg string
+		errIs  error
+	}{
+		{
+			name:   "invalid frontmatter",
+			files:  map[string]string{"valid.md": valid, "invalid.md": "---\ndescription: [\n---\nContent."},
+			errMsg: "failed to parse frontmatter for invalid.md",
+			errIs:  nil,
+		},
+		{
+			name:   "oversized standard",
+			files:  map[string]string{"valid.md": valid, "large.md": valid + strings.Repeat("x", 100)},
+			errMsg: "exceeds maximum limit of 64 B (64 bytes): large.md is 137 B (137 bytes)",
+			errIs:  domain.ErrFileTooLarge,
+		},
+		{
+			name:   "too many standards",
+			files:  map[string]string{"a.md": valid, "b.md": valid, "c.md": valid},
+			errMsg: "exceeds maximum limit of 2",
+			errIs:  domain.ErrTooManyStandards,
+		},
+		{
+			name:   "path traversal",
+			files:  map[string]string{"../outside.md": valid},
+			errMsg: "path traversal detected",
+			errIs:  domain.ErrPathTraversal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archivePath := writeZipArchive(t, tt.files)
+			t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE", "64")
+			t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARDS", "2")
+
+			loader, err := NewArchiveStandardLoader(archivePath)
+			if err != nil {
+				t.Fatalf("NewArchiveStandardLoader() error = %v", err)
+			}
+
+			_, err = loader.ListStandards(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("ArchiveStandardLoader.ListStandards() error = %v, expected to contain %q", err, tt.errMsg)
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("ArchiveStandardLoader.ListStandards() error = %v, expected to wrap %v", err, tt.errIs)
+			}
+		})
+	}
+}
+
+func TestArchiveStandardLoader_SkipOversized(t *testing.T) {
+	valid := "---\ndescription: \"Valid\"\n---\nContent."
+	archivePath := writeZipArchive(t, map[string]string{
+		"valid.md": valid,
+		"large.md": valid + strings.Repeat("x", 100),
+	})
+	t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE", "64")
+	t.Setenv("AGENT_STANDARDS_MCP_STRICT", "false")
+
+	loader, err := NewArchiveStandardLoader(archivePath)
+	if err != nil {
+		t.Fatalf("NewArchiveStandardLoader() error = %v", err)
+	}
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+		AddSource:   false,
+		Level:       slog.LevelWarn,
+		ReplaceAttr: nil,
+	}))
+	ctx := shared.WithLogger(context.Background(), logger)
+
+	infos, err := loader.ListStandards(ctx)
+	if err != nil {
+		t.Fatalf("ArchiveStandardLoader.ListStandards() error = %v", err)
+	}
+	if len(infos) != 1 || infos[0].Name != "valid" {
+		t.Errorf("ArchiveStandardLoader.ListStandards() = %+v, expected only valid", infos)
+	}
+	if !strings.Contains(logs.String(), "Skipping oversized standard") || !strings.Contains(logs.String(), "large.md") {
+		t.Errorf("logs = %q, expected a warning about large.md", logs.String())
+	}
+
+	standards, _, err := loader.GetStandards(ctx, []string{"valid", "large"})
+	if err != nil {
+		t.Fatalf("ArchiveStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 1 || standards[0].Name != "valid" {
+		t.Errorf("ArchiveStandardLoader.GetStandards() = %+v, expected only valid", standards)
+	}
+}
+
+func TestSingleFileStandardLoader(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "standards.md")
+	t.Setenv("AGENT_STANDARDS_MCP_SINGLE_FILE", filePath)
+
+	content := strings.Join([]string{
+		"---",
+		"name: go-errors",
+		"description: \"Go error handling\"",
+		"---",
+		"# Errors",
+		"",
+		"Wrap errors.",
+		"",
+		"---",
+		"",
+		"After a horizontal rule.",
+		"```yaml",
+		"---",
+		"name: not-a-standard",
+		"---",
+		"```",
+		"---",
+		"title: Code Review",
+		"description: \"Review checklist\"",
+		"priority: 0.5",
+		"---",
+		"Review carefully.",
+		"---",
+		"name: go-errors",
+		"description: \"Duplicate\"",
+		"---",
+		"Ignored.",
+	}, "\n")
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	loader, err := NewSingleFileStandardLoader()
+	if err != nil {
+		t.Fatalf("NewSingleFileStandardLoader() error = %v", err)
+	}
+
+	infos, err := loader.ListStandards(context.Background())
+	if err != nil {
+		t.Fatalf("SingleFileStandardLoader.ListStandards() error = %v", err)
+	}
+	if len(infos) != 2 || infos[0].Name != "go-errors" || infos[1].Name != "code-review" {
+		t.Fatalf("SingleFileStandardLoader.ListStandards() = %+v, expected go-errors and code-review", infos)
+	}
+	if infos[0].Description != "Go error handling" {
+		t.Errorf("SingleFileStandardLoader.ListStandards()[0].Description = %q, expected the first document", infos[0].Description)
+	}
+
+	standard, err := loader.GetStandard(context.Background(), "go-errors")
+	if err != nil {
+		t.Fatalf("SingleFileStandardLoader.GetStandard() error = %v", err)
+	}
+	if !strings.Contains(standard.Content, "After a horizontal rule.") ||
+		!strings.Contains(standard.Content, "name: not-a-standard") {
+		t.Errorf("SingleFileStandardLoader.GetStandard().Content = %q, expected the rule and code block kept", standard.Content)
+	}
+
+	standards, _, err := loader.GetStandards(context.Background(), []string{"code-review", "missing"})
+	if err != nil {
+		t.Fatalf("SingleFileStandardLoader.GetStandards() error = %v", err)
+	}
+	if len(standards) != 1 || standards[0].Content != "Review carefully." || standards[0].Title != "Code Review" {
+		t.Errorf("SingleFileStandardLoader.GetStandards() = %+v, expected the code-review standard", standards)
+	}
+
+	if _, err := loader.GetStandard(context.Background(), "missing"); !errors.Is(err, domain.ErrStandardNotFound) {
+		t.Errorf("SingleFileStandardLoader.GetStandard() error = %v, expected to wrap %v", err, domain.ErrStandardNotFound)
+	}
+}
+
+func TestSingleFileStandardLoader_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		maxSize     string
+		expectedErr error
+		errContains string
+	}{
+		{
+			name:        "no frontmatter",
+			content:     "# Just markdown",
+			errContains: "must start with a frontmatter block",
+		},
+		{
+			name:        "no name or title",
+			content:     "---\ndescription: \"Anonymous\"\n---\nContent",
+			errContains: "no name or title",
+		},
+		{
+			name:        "oversized document",
+			content:     "---\nname: a\ndescription: \"A\"\n---\n" + strings.Repeat("x", 100),
+			maxSize:     "64",
+			expectedErr: domain.ErrFileTooLarge,
+		},
+		{
+			name:        "oversized file",
+			content:     "---\nname: a\ndescription: \"A\"\n---\n" + strings.Repeat("x", 200),
+			maxSize:     "100",
+			expectedErr: domain.ErrFileTooLarge,
+			errContains: "standards.md",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "standards.md")
+			t.Setenv("AGENT_STANDARDS_MCP_SINGLE_FILE", filePath)
+			t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARDS", "2")
+			t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE", tt.maxSize)
+
+			if err := os.WriteFile(filePath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write test file: %v", err)
+			}
+
+			loader, err := NewSingleFileStandardLoader()
+			if err != nil {
+				t.Fatalf("NewSingleFileStandardLoader() error = %v", err)
+			}
+
+			_, err = loader.ListStandards(context.Background())
+			if err == nil {
+				t.Fatal("SingleFileStandardLoader.ListStandards() expected error, got nil")
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("SingleFileStandardLoader.ListStandards() error = %v, expected to wrap %v", err, tt.expectedErr)
+			}
+			if tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("SingleFileStandardLoader.ListStandards() error = %v, expected to contain %q", err, tt.errContains)
+			}
+		})
+	}
+}
+
+func TestRetryingReader(t *testing.T) {
+	transientErr := &os.PathError{Op: "read", Path: "standard.md", Err: syscall.EIO}
+	permissionErr := &os.PathError{Op: "open", Path: "standard.md", Err: os.ErrPermission}
+
+	tests := []struct {
+		name          string
+		retries       int
+		failures      []error
+		expectedCalls int
+		expectedErr   error
+	}{
+		{"transient error retried", 2, []error{transientErr}, 2, nil},
+		{"no retries by default", 0, []error{transientErr}, 1, syscall.EIO},
+		{"retries exhausted", 1, []error{transientErr, transientErr, transientErr}, 2, syscall.EIO},
+		{"permission error not retried", 3, []error{permissionErr}, 1, os.ErrPermission},
+		{"missing file not retried", 3, []error{os.ErrNotExist}, 1, os.ErrNotExist},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			reader := retryingReader{
+				read: func(string) ([]byte, error) {
+					calls++
+					if calls <= len(tt.failures) {
+						return nil, tt.failures[calls-1]
+					}
+					return []byte("content"), nil
+				},
+				retries: tt.retries,
+				delay:   time.Millisecond,
+			}
+
+			content, err := reader.readFile(context.Background(), "standard.md")
+			if calls != tt.expectedCalls {
+				t.Errorf("retryingReader.readFile() made %d reads, expected %d", calls, tt.expectedCalls)
+			}
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("retryingReader.readFile() error = %v, expected to wrap %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil || string(content) != "content" {
+				t.Errorf("retryingReader.readFile() = %q, %v, expected the content", content, err)
+			}
+		})
+	}
+}
+
+func TestFileStandardLoader_ReadRetries(t *testing.T) {
+	tempDir := t.TempDir()
+
+	t.Setenv("AGENT_STANDARDS_MCP_READ_RETRIES", "1")
+
+	if err := os.WriteFile(filepath.Join(tempDir, "flaky.md"), []byte("---\ndescription: \"Flaky\"\n---\nContent"), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	loader := NewFileStandardLoader(tempDir)
+	failed := false
+	loader.reader.read = func(name string) ([]byte, error) {
+		// Fail the first read once, as a network filesystem might
+		if !failed {
+			failed = true
+			return nil, &os.PathError{Op: "read", Path: name, Err: syscall.ETIMEDOUT}
+		}
+		return os.ReadFile(name)
+	}
+	loader.reader.delay = time.Millisecond
+
+	standard, err := loader.GetStandard(context.Background(), "flaky")
+	if err != nil {
+		t.Fatalf("FileStandardLoader.GetStandard() error = %v", err)
+	}
+	if !failed || standard.Content != "Content" {
+		t.Errorf("FileStandardLoader.GetStandard() = %+v, expected the content after a retry", standard)
+	}
+}
@@ -0,0 +1,59 @@
+package standards
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/shared"
+)
+
+// htmlCommentPattern matches HTML comments, including multi-line ones.
+var htmlCommentPattern = regexp.MustCompile(`(?s)<!--.*?-->`)
+
+// contentTransform holds the optional post-processing steps applied to standard content.
+type contentTransform struct {
+	normalizeNewlines bool
+	stripComments     bool
+	demoteHeadings    bool
+}
+
+// apply post-processes the content of a standard. Content requested raw by the context is returned as is.
+func (t contentTransform) apply(ctx context.Context, content string) string {
+	if shared.IsRawContent(ctx) {
+		return content
+	}
+
+	if t.normalizeNewlines {
+		content = normalizeNewlines(content)
+	}
+
+	if t.stripComments {
+		content = strings.TrimSpace(htmlCommentPattern.ReplaceAllString(content, ""))
+	}
+
+	if t.demoteHeadings {
+		content = shared.DemoteHeadings(content)
+	}
+
+	return content
+}
+
+// serve returns the content served for a parsed standard: the post-processed body, preceded by
+// the frontmatter block when the context requests it. Raw content with the frontmatter is the file as authored.
+func (t contentTransform) serve(ctx context.Context, parsed parsedStandard) string {
+	if !shared.IsFrontmatterIncluded(ctx) || parsed.rawFrontmatter == "" {
+		return t.apply(ctx, parsed.body)
+	}
+
+	if shared.IsRawContent(ctx) {
+		return parsed.fullContent
+	}
+
+	return parsed.rawFrontmatter + "\n\n" + t.apply(ctx, parsed.body)
+}
+
+// normalizeNewlines turns CRLF and lone CR line endings into LF.
+func normalizeNewlines(content string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(content, "\r\n", "\n"), "\r", "\n")
+}
@@ -6,14 +6,17 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
 )
 
 // validateFile validates a single standard file against security and size constraints.
 // allowedDir is the base directory that files must be located within.
 func validateFile(filePath, allowedDir string) error {
 	// Check for path traversal attempts
-	if isPathTraversal(filePath, allowedDir) {
-		return fmt.Errorf("path traversal detected: %s", filePath)
+	if err := checkWithinDir(filePath, allowedDir); err != nil {
+		return err
 	}
 
 	// Check if file exists
@@ -36,8 +39,14 @@ func validateFile(filePath, allowedDir string) error {
 		return fmt.Errorf("failed to get max standard size: %w", err)
 	}
 
-	if fileInfo.Size() > maxSize {
-		return fmt.Errorf("file size exceeds maximum limit of %d bytes: %d", maxSize, fileInfo.Size())
+	// The limit applies to the decrypted content of encrypted standards
+	size := fileInfo.Size()
+	if isEncryptedPath(filePath) {
+		size = max(size-encryptionOverhead, 0)
+	}
+
+	if size > maxSize {
+		return &FileTooLargeError{Path: filePath, Size: size, Limit: maxSize}
 	}
 
 	return nil
@@ -53,7 +62,7 @@ func validateStandardFiles(filePaths []string, allowedDir string) error {
 	}
 
 	if len(filePaths) > maxStandards {
-		return fmt.Errorf("number of files exceeds maximum limit of %d: %d", maxStandards, len(filePaths))
+		return fmt.Errorf("%w of %d: %d", domain.ErrTooManyStandards, maxStandards, len(filePaths))
 	}
 
 	// Validate each file
@@ -74,9 +83,10 @@ func getMaxStandardSize() (int64, error) {
 		return oneMB, nil
 	}
 
-	size, err := strconv.ParseInt(sizeStr, 10, 64)
+	// Sizes may have a unit, e.g. 64KB, the same as in the configuration
+	size, err := config.ParseSize(sizeStr)
 	if err != nil {
-		return 0, fmt.Errorf("invalid AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE value: %s", sizeStr)
+		return 0, fmt.Errorf("invalid AGENT_STANDARDS_MCP_MAX_STANDARD_SIZE value: %w", err)
 	}
 
 	return size, nil
@@ -98,25 +108,30 @@ func getMaxStandards() (int, error) {
 	return count, nil
 }
 
-// isPathTraversal checks if a path attempts directory traversal
-func isPathTraversal(filePath, allowedDir string) bool {
+// checkWithinDir checks that a path lies within the allowed directory. A path outside of it is reported as
+// domain.ErrPathTraversal, while a malformed path or a failure to resolve a path is reported as a distinct error,
+// so that it is not mistaken for an attack. Paths inside the directory, such as "..notes.md", always pass.
+func checkWithinDir(filePath, allowedDir string) error {
+	if filePath == "" || strings.ContainsRune(filePath, 0) {
+		return fmt.Errorf("invalid path %q", filePath)
+	}
+
 	// Convert to absolute paths for comparison
 	absAllowed, err := filepath.Abs(allowedDir)
 	if err != nil {
-		return true
+		return fmt.Errorf("failed to resolve allowed directory %s: %w", allowedDir, err)
 	}
 
 	absFile, err := filepath.Abs(filePath)
 	if err != nil {
-		return true
+		return fmt.Errorf("failed to resolve path %s: %w", filePath, err)
 	}
 
-	// Check if the file path is within the allowed directory
+	// A path that cannot be made relative to the directory, e.g. on another volume, is outside of it
 	rel, err := filepath.Rel(absAllowed, absFile)
-	if err != nil {
-		return true
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("%w: %s is outside the allowed directory %s", domain.ErrPathTraversal, filePath, absAllowed)
 	}
 
-	// Check if the relative path starts with ".." indicating traversal
-	return strings.HasPrefix(rel, ".."+string(filepath.Separator)) || rel == ".."
+	return nil
 }
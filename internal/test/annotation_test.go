@@ -0,0 +1,45 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetStandards_PriorityAnnotations tests that standard content is annotated with the frontmatter priority
+func TestGetStandards_PriorityAnnotations(t *testing.T) {
+	t.Setenv("AGENT_STANDARDS_MCP_CONTENT_ANNOTATIONS", "true")
+
+	suite := NewTestSuite(t, WithCustomStandardFiles(map[string]string{
+		"critical.md": "---\ndescription: \"Critical standard\"\npriority: 0.9\n---\nCritical content",
+		"optional.md": "---\ndescription: \"Optional standard\"\n---\nOptional content",
+	}))
+	defer suite.Cleanup()
+
+	result := AssertToolCallSuccess(t, suite, "get_standards", map[string]any{
+		"standard_names": []string{"critical", "optional"},
+	})
+
+	// Instructions come first, followed by one block per standard
+	require.Len(t, result.Content, 3, "Expected instructions and a content block per standard")
+
+	critical, ok := result.Content[1].(*mcp.TextContent)
+	require.True(t, ok)
+	require.Contains(t, critical.Text, "Critical content")
+	require.NotNil(t, critical.Annotations)
+	require.InDelta(t, 0.9, critical.Annotations.Priority, 1e-9)
+	require.Equal(t, []mcp.Role{"assistant"}, critical.Annotations.Audience)
+
+	optional, ok := result.Content[2].(*mcp.TextContent)
+	require.True(t, ok)
+	require.Contains(t, optional.Text, "Optional content")
+	require.NotNil(t, optional.Annotations)
+	require.Zero(t, optional.Annotations.Priority)
+
+	// The structured result still holds the complete text
+	structured, ok := result.StructuredContent.(map[string]any)
+	require.True(t, ok)
+	require.Contains(t, structured["result"], "Critical content")
+	require.Contains(t, structured["result"], "Optional content")
+}
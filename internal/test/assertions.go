@@ -83,6 +83,16 @@ func AssertPlainTextInput(t *testing.T, result *mcp.CallToolResult) string {
 	return textContent.Text
 }
 
+// AssertErrorText validates that an error result contains a text message and returns it
+func AssertErrorText(t *testing.T, result *mcp.CallToolResult) string {
+	require.NotEmpty(t, result.Content, "Error result should contain content")
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok, "Content should be TextContent")
+
+	return textContent.Text
+}
+
 // AssertStandardListContains validates that plain text contains a specific standard by name
 func AssertStandardListContains(t *testing.T, plainText string, standardName string) {
 	expectedPattern := standardName + ":"
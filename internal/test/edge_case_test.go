@@ -1,6 +1,10 @@
 package test
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -38,6 +42,24 @@ func TestGetStandards_MixOfExistentAndNonExistent(t *testing.T) {
 	AssertStandardListContains(t, plainText, "standard2")
 	AssertStandardListCount(t, plainText, 2)
 	AssertMultipleStandardsFormat(t, plainText)
+
+	// Missing standards are reported as warnings in the structured content
+	structured, ok := result.StructuredContent.(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, []any{"standard nonexistent1 not found", "standard nonexistent2 not found"},
+		structured["warnings"])
+}
+
+// TestGetStandardMeta_NotFound tests that a missing standard is reported as a typed NOT_FOUND error
+func TestGetStandardMeta_NotFound(t *testing.T) {
+	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+	defer suite.Cleanup()
+
+	result := AssertToolCallError(t, suite, "get_standard_meta", map[string]any{"name": "nonexistent"})
+
+	structured, ok := result.StructuredContent.(map[string]any)
+	require.True(t, ok, "Typed errors should carry structured content")
+	require.Equal(t, map[string]any{"code": "NOT_FOUND", "name": "nonexistent"}, structured["error"])
 }
 
 // TestGetStandards_NoFrontmatter tests getting a standard with no frontmatter
@@ -88,6 +110,114 @@ func TestListStandards_ComplexStandard(t *testing.T) {
 	AssertMultipleStandardsFormat(t, plainText)
 }
 
+// TestListStandards_DefaultDescription tests the configured description of a standard without frontmatter
+func TestListStandards_DefaultDescription(t *testing.T) {
+	t.Setenv("AGENT_STANDARDS_MCP_DEFAULT_DESCRIPTION", "(no description)")
+
+	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+	defer suite.Cleanup()
+
+	result := AssertToolCallSuccess(t, suite, "list_standards", map[string]any{})
+
+	plainText := AssertPlainTextInput(t, result)
+	AssertStandardContainsDescription(t, plainText, "no-description", "(no description)")
+	AssertStandardContainsDescription(t, plainText, "standard1", "A test standard for basic functionality")
+}
+
+// TestListStandards_DisplayNames tests that humanized names are displayed while canonical names still resolve
+func TestListStandards_DisplayNames(t *testing.T) {
+	t.Setenv("AGENT_STANDARDS_MCP_DISPLAY_NAMES", "titlecase")
+
+	suite := NewTestSuite(t, WithCustomStandardFiles(map[string]string{
+		"error-handling.md": "---\ndescription: Wrap errors with context\n---\nUse fmt.Errorf with %w",
+	}))
+	defer suite.Cleanup()
+
+	result := AssertToolCallSuccess(t, suite, "list_standards", map[string]any{})
+	require.Contains(t, AssertPlainTextInput(t, result), "error-handling (Error Handling): Wrap errors with context")
+
+	result = AssertToolCallSuccess(t, suite, "get_standards", map[string]any{
+		"standard_names": []string{"error-handling"},
+	})
+	AssertStandardContainsContent(t, AssertPlainTextInput(t, result), "error-handling", "Use fmt.Errorf with %w")
+}
+
+// TestToolCall_MaxInputBytes tests that tool calls with an oversized input are rejected before processing
+func TestToolCall_MaxInputBytes(t *testing.T) {
+	t.Setenv("AGENT_STANDARDS_MCP_MAX_INPUT_BYTES", "1KB")
+
+	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+	defer suite.Cleanup()
+
+	names := make([]string, 1000)
+	for i := range names {
+		names[i] = fmt.Sprintf("standard%d", i)
+	}
+
+	result := AssertToolCallError(t, suite, "get_standards", map[string]any{"standard_names": names})
+	require.Contains(t, AssertPlainTextInput(t, result), "exceeds the maximum input size of 1024 bytes")
+
+	// Inputs within the limit are served as usual
+	result = AssertToolCallSuccess(t, suite, "get_standards", map[string]any{
+		"standard_names": []string{"standard1"},
+	})
+	AssertStandardListContains(t, AssertPlainTextInput(t, result), "standard1")
+}
+
+// TestStandardLimits_Independent tests that the folder cap on the number of standards and the per-request cap
+// on the number of names are enforced independently
+func TestStandardLimits_Independent(t *testing.T) {
+	t.Run("per-request cap", func(t *testing.T) {
+		t.Setenv("AGENT_STANDARDS_MCP_MAX_GET_NAMES", "2")
+
+		suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+		defer suite.Cleanup()
+
+		// The folder holds more standards than one call may request, they are all listed
+		result := AssertToolCallSuccess(t, suite, "list_standards", map[string]any{})
+		AssertStandardListCount(t, AssertPlainTextInput(t, result), len(DefaultStandardFiles()))
+
+		result = AssertToolCallError(t, suite, "get_standards", map[string]any{
+			"standard_names": []string{"standard1", "standard2", "standard3"},
+		})
+		require.Contains(t, AssertErrorText(t, result), "too many standard names: 3 (maximum is 2 per call)")
+
+		AssertToolCallSuccess(t, suite, "get_standards", map[string]any{
+			"standard_names": []string{"standard1", "standard2"},
+		})
+	})
+
+	t.Run("folder cap", func(t *testing.T) {
+		t.Setenv("AGENT_STANDARDS_MCP_MAX_STANDARDS", "3")
+
+		suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+		defer suite.Cleanup()
+
+		// The folder holds more standards than allowed, listing fails regardless of the per-request cap
+		result := AssertToolCallError(t, suite, "list_standards", map[string]any{})
+		require.Contains(t, AssertErrorText(t, result), "number of standards exceeds maximum limit of 3")
+
+		// Loading a few standards by name is bounded by the per-request cap only
+		AssertToolCallSuccess(t, suite, "get_standards", map[string]any{"standard_names": []string{"standard1"}})
+	})
+}
+
+// TestSelfTest tests the self-test against a healthy and an empty standards folder
+func TestSelfTest(t *testing.T) {
+	healthy := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+	defer healthy.Cleanup()
+
+	report := healthy.Server.Server.SelfTest(context.Background())
+	require.True(t, report.Passed(), report.String())
+
+	empty := NewTestSuite(t, WithCustomStandardFiles(EmptyStandardFiles()))
+	defer empty.Cleanup()
+
+	report = empty.Server.Server.SelfTest(context.Background())
+	require.False(t, report.Passed())
+	require.Contains(t, report.String(), "FAIL list standards: no standards found")
+}
+
 // TestGetStandards_DuplicateStandardNames tests requesting the same standard multiple times
 func TestGetStandards_DuplicateStandardNames(t *testing.T) {
 	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
@@ -106,6 +236,19 @@ func TestGetStandards_DuplicateStandardNames(t *testing.T) {
 	AssertMultipleStandardsFormat(t, plainText)
 }
 
+// TestGetStandards_SingleStringName tests that a lone string is accepted in place of an array
+func TestGetStandards_SingleStringName(t *testing.T) {
+	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+	defer suite.Cleanup()
+
+	result := AssertToolCallSuccess(t, suite, "get_standards", map[string]any{
+		"standard_names": "standard1",
+	})
+
+	plainText := AssertPlainTextInput(t, result)
+	AssertGetStandardsContainsContent(t, plainText, "standard1", "A test standard for basic functionality", "This is the content of standard1")
+}
+
 // TestGetStandards_ParameterValidationMissing tests that missing required parameter is caught
 func TestGetStandards_ParameterValidationMissing(t *testing.T) {
 	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
@@ -138,7 +281,7 @@ func TestGetStandards_ParameterValidationWrongType(t *testing.T) {
 		Meta: mcp.Meta{},
 		Name: "get_standards",
 		Arguments: map[string]any{
-			"standard_names": "should-be-array", // Should be array, not string
+			"standard_names": 42, // Should be a string or an array, not a number
 		},
 	})
 
@@ -172,3 +315,23 @@ func requireIndexOfSubstring(text, substring string) int {
 	}
 	return -1
 }
+
+// TestListStandards_FolderDisappeared tests that a standards folder removed at runtime yields no standards
+func TestListStandards_FolderDisappeared(t *testing.T) {
+	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+	defer suite.Cleanup()
+
+	result := AssertToolCallSuccess(t, suite, "list_standards", map[string]any{})
+	AssertStandardListContains(t, AssertPlainTextInput(t, result), "standard1")
+
+	// Simulate an unmounted volume
+	require.NoError(t, os.RemoveAll(suite.Server.Config.GetFolder()))
+
+	result = AssertToolCallSuccess(t, suite, "list_standards", map[string]any{})
+	require.Equal(t, "No standards found.", AssertPlainTextInput(t, result))
+
+	result = AssertToolCallSuccess(t, suite, "get_standards", map[string]any{
+		"standard_names": []string{"standard1"},
+	})
+	require.True(t, strings.HasPrefix(AssertPlainTextInput(t, result), "No standards found."))
+}
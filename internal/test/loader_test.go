@@ -0,0 +1,103 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/n-r-w/agent-standards-mcp/internal/config"
+	"github.com/n-r-w/agent-standards-mcp/internal/domain"
+	"github.com/n-r-w/agent-standards-mcp/internal/logging"
+	"github.com/n-r-w/agent-standards-mcp/internal/server"
+	"github.com/n-r-w/agent-standards-mcp/internal/standards"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// TestSuite_WithLoader tests that an injected loader serves the tools without reading standard files
+func TestSuite_WithLoader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	loader := server.NewMockStandardLoader(ctrl)
+
+	loader.EXPECT().
+		ListStandards(gomock.Any()).
+		Return([]domain.StandardInfo{{Name: "in-memory", Description: "In-memory standard"}}, nil)
+	loader.EXPECT().
+		GetStandards(gomock.Any(), []string{"in-memory"}).
+		Return([]domain.Standard{{Name: "in-memory", Description: "In-memory standard", Content: "In-memory content"}}, nil, nil)
+
+	suite := NewTestSuite(t, WithLoader(loader))
+	defer suite.Cleanup()
+
+	require.Same(t, loader, suite.Server.StandardLoader, "Suite should expose the injected loader")
+
+	result := AssertToolCallSuccess(t, suite, "list_standards", map[string]any{})
+	plainText := AssertPlainTextInput(t, result)
+	AssertStandardListContains(t, plainText, "in-memory")
+
+	result = AssertToolCallSuccess(t, suite, "get_standards", map[string]any{
+		"standard_names": []string{"in-memory"},
+	})
+	plainText = AssertPlainTextInput(t, result)
+	AssertGetStandardsContainsContent(t, plainText, "in-memory", "In-memory standard", "In-memory content")
+}
+
+// TestPrewarm_PopulatesCache tests that the pre-warm fills the HTTP loader cache before the first tool call
+func TestPrewarm_PopulatesCache(t *testing.T) {
+	var indexRequests atomic.Int32
+	standardsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/index.json" {
+			http.NotFound(w, r)
+			return
+		}
+		indexRequests.Add(1)
+		_, _ = w.Write([]byte(`{"standards": [{"name": "remote", "description": "Remote standard", "path": "remote.md"}]}`))
+	}))
+	defer standardsServer.Close()
+
+	loader, err := standards.NewHTTPStandardLoader(standardsServer.URL)
+	require.NoError(t, err)
+
+	cfg := config.Default()
+	cfg.LogLevel = string(config.LogLevelNone)
+	cfg.Prewarm = true
+
+	loggerFactory := logging.NewLoggerFactory()
+	structuredLogger, err := loggerFactory.CreateStructuredLogger(cfg)
+	require.NoError(t, err)
+	auditLogger, err := loggerFactory.CreateAudit(cfg)
+	require.NoError(t, err)
+
+	mcpServer, err := server.New(cfg, structuredLogger, auditLogger, loader, server.BuildInfo{Version: "dev", Commit: "", Date: "", BuiltBy: ""})
+	require.NoError(t, err)
+	require.NoError(t, mcpServer.RegisterTools())
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		_ = mcpServer.StartWithTransport(ctx, serverTransport)
+	}()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0", Title: "test-client"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	// The server accepts the connection only after the pre-warm
+	require.Equal(t, int32(1), indexRequests.Load(), "Index should be fetched by the pre-warm")
+
+	result, err := clientSession.CallTool(ctx, &mcp.CallToolParams{
+		Meta:      mcp.Meta{},
+		Name:      "list_standards",
+		Arguments: map[string]any{},
+	})
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	require.Equal(t, int32(1), indexRequests.Load(), "First call should be served from the cache")
+}
@@ -0,0 +1,178 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// notificationTimeout is the maximum time to wait for an asynchronous notification
+	notificationTimeout = 2 * time.Second
+	// notificationPollInterval is the interval between notification checks
+	notificationPollInterval = 10 * time.Millisecond
+)
+
+// TestClientLogs_ForwardedToClient tests that server logs are forwarded to the client as logging notifications
+func TestClientLogs_ForwardedToClient(t *testing.T) {
+	t.Setenv("AGENT_STANDARDS_MCP_CLIENT_LOGS", "DEBUG")
+
+	var (
+		mu       sync.Mutex
+		messages []*mcp.LoggingMessageParams
+	)
+
+	suite := NewTestSuite(t,
+		WithCustomStandardFiles(DefaultStandardFiles()),
+		WithClientOptions(&mcp.ClientOptions{
+			LoggingMessageHandler: func(_ context.Context, req *mcp.LoggingMessageRequest) {
+				mu.Lock()
+				defer mu.Unlock()
+				messages = append(messages, req.Params)
+			},
+		}),
+	)
+	defer suite.Cleanup()
+
+	// The client must opt in to logging notifications
+	err := suite.ClientSession.SetLoggingLevel(getContext(), &mcp.SetLoggingLevelParams{
+		Meta:  mcp.Meta{},
+		Level: "debug",
+	})
+	require.NoError(t, err)
+
+	AssertToolCallSuccess(t, suite, "list_standards", map[string]any{})
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, message := range messages {
+			if message.Logger == "agent-standards-mcp" && message.Level == "debug" {
+				return true
+			}
+		}
+		return false
+	}, notificationTimeout, notificationPollInterval, "Expected a forwarded debug log notification")
+}
+
+// TestClientLogs_DisabledByDefault tests that no logs are forwarded when client logging is not configured
+func TestClientLogs_DisabledByDefault(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		count int
+	)
+
+	suite := NewTestSuite(t,
+		WithCustomStandardFiles(DefaultStandardFiles()),
+		WithClientOptions(&mcp.ClientOptions{
+			LoggingMessageHandler: func(_ context.Context, _ *mcp.LoggingMessageRequest) {
+				mu.Lock()
+				defer mu.Unlock()
+				count++
+			},
+		}),
+	)
+	defer suite.Cleanup()
+
+	err := suite.ClientSession.SetLoggingLevel(getContext(), &mcp.SetLoggingLevelParams{
+		Meta:  mcp.Meta{},
+		Level: "debug",
+	})
+	require.NoError(t, err)
+
+	AssertToolCallSuccess(t, suite, "list_standards", map[string]any{})
+
+	// Give any stray notification time to arrive
+	time.Sleep(serverStartupDelay)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Zero(t, count, "No log notifications should be forwarded when client logging is disabled")
+}
+
+// TestProgress_ReportedForLargeFolder tests that listing a large folder sends progress notifications
+// for a request carrying a progress token
+func TestProgress_ReportedForLargeFolder(t *testing.T) {
+	const standardCount = 45
+
+	files := make(map[string]string, standardCount)
+	for i := range standardCount {
+		files[fmt.Sprintf("standard%02d.md", i)] = fmt.Sprintf("---\ndescription: \"Standard %d\"\n---\nContent %d", i, i)
+	}
+
+	var (
+		mu       sync.Mutex
+		progress []*mcp.ProgressNotificationParams
+	)
+
+	suite := NewTestSuite(t,
+		WithCustomStandardFiles(files),
+		WithClientOptions(&mcp.ClientOptions{
+			ProgressNotificationHandler: func(_ context.Context, req *mcp.ProgressNotificationClientRequest) {
+				mu.Lock()
+				defer mu.Unlock()
+				progress = append(progress, req.Params)
+			},
+		}),
+	)
+	defer suite.Cleanup()
+
+	params := &mcp.CallToolParams{
+		Meta:      mcp.Meta{},
+		Name:      "list_standards",
+		Arguments: map[string]any{},
+	}
+	params.SetProgressToken("list-progress")
+
+	result, err := suite.ClientSession.CallTool(getContext(), params)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(progress) == 0 {
+			return false
+		}
+		last := progress[len(progress)-1]
+		return last.ProgressToken == "list-progress" && last.Progress == standardCount && last.Total == standardCount
+	}, notificationTimeout, notificationPollInterval, "Expected a final progress notification")
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, progress, 5, "Expected a notification every 10 standards and one on completion")
+}
+
+// TestProgress_NotReportedWithoutToken tests that no progress notifications are sent without a progress token
+func TestProgress_NotReportedWithoutToken(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		count int
+	)
+
+	suite := NewTestSuite(t,
+		WithCustomStandardFiles(DefaultStandardFiles()),
+		WithClientOptions(&mcp.ClientOptions{
+			ProgressNotificationHandler: func(_ context.Context, _ *mcp.ProgressNotificationClientRequest) {
+				mu.Lock()
+				defer mu.Unlock()
+				count++
+			},
+		}),
+	)
+	defer suite.Cleanup()
+
+	AssertToolCallSuccess(t, suite, "list_standards", map[string]any{})
+
+	// Give any stray notification time to arrive
+	time.Sleep(serverStartupDelay)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Zero(t, count, "No progress notifications should be sent without a progress token")
+}
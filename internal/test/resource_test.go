@@ -0,0 +1,38 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+// TestResources_FrontmatterSchema tests reading the frontmatter JSON Schema resource
+func TestResources_FrontmatterSchema(t *testing.T) {
+	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+	defer suite.Cleanup()
+
+	// The resource must be advertised
+	listResult, err := suite.ClientSession.ListResources(getContext(), &mcp.ListResourcesParams{})
+	require.NoError(t, err)
+	require.Len(t, listResult.Resources, 1, "Expected a single resource")
+	require.Equal(t, "schema://frontmatter", listResult.Resources[0].URI)
+
+	readResult, err := suite.ClientSession.ReadResource(getContext(), &mcp.ReadResourceParams{
+		Meta: mcp.Meta{},
+		URI:  "schema://frontmatter",
+	})
+	require.NoError(t, err)
+	require.Len(t, readResult.Contents, 1, "Expected a single resource content")
+	require.Equal(t, "application/schema+json", readResult.Contents[0].MIMEType)
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal([]byte(readResult.Contents[0].Text), &schema), "Schema should be valid JSON")
+	require.Equal(t, "object", schema["type"])
+
+	properties, ok := schema["properties"].(map[string]any)
+	require.True(t, ok, "Schema should describe frontmatter properties")
+	require.Contains(t, properties, "description")
+	require.Contains(t, properties, "title")
+}
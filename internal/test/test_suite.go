@@ -46,6 +46,9 @@ type setupConfig struct {
 	standardFiles map[string]string
 	clientName    string
 	clientVersion string
+	clientOptions *mcp.ClientOptions
+	loader        server.StandardLoader
+	buildInfo     server.BuildInfo
 }
 
 // WithCustomStandardFiles configures custom standard files
@@ -70,6 +73,28 @@ func WithClientInfo(name, version string) SetupOption {
 	}
 }
 
+// WithClientOptions configures the MCP client options, e.g. notification handlers
+func WithClientOptions(opts *mcp.ClientOptions) SetupOption {
+	return func(c *setupConfig) {
+		c.clientOptions = opts
+	}
+}
+
+// WithLoader configures the server to use the given standard loader instead of reading standard files.
+// No files are written and no environment variables are changed, so it cannot be combined with the command transport.
+func WithLoader(loader server.StandardLoader) SetupOption {
+	return func(c *setupConfig) {
+		c.loader = loader
+	}
+}
+
+// WithBuildInfo configures the build information the server reports to clients
+func WithBuildInfo(info server.BuildInfo) SetupOption {
+	return func(c *setupConfig) {
+		c.buildInfo = info
+	}
+}
+
 // NewTestSuite creates a complete integration test environment
 func NewTestSuite(t *testing.T, opts ...SetupOption) *Suite {
 	// Default configuration
@@ -78,6 +103,9 @@ func NewTestSuite(t *testing.T, opts ...SetupOption) *Suite {
 		standardFiles: DefaultStandardFiles(),
 		clientName:    "test-client",
 		clientVersion: "1.0.0",
+		clientOptions: nil,
+		loader:        nil,
+		buildInfo:     server.BuildInfo{Version: "dev", Commit: "unknown", Date: "unknown", BuiltBy: "unknown"},
 	}
 
 	// Apply options
@@ -85,8 +113,12 @@ func NewTestSuite(t *testing.T, opts ...SetupOption) *Suite {
 		opt(config)
 	}
 
+	if config.loader != nil && config.transportType == "command" {
+		require.FailNow(t, "WithLoader cannot be combined with WithCommandTransport")
+	}
+
 	// Create test server
-	testServer := createTestServer(t, config.standardFiles)
+	testServer := createTestServer(t, config.standardFiles, config.loader, config.buildInfo)
 
 	var clientTransport mcp.Transport
 	var cleanupFuncs []func()
@@ -126,7 +158,7 @@ func NewTestSuite(t *testing.T, opts ...SetupOption) *Suite {
 		Name:    config.clientName,
 		Version: config.clientVersion,
 		Title:   config.clientName,
-	}, nil)
+	}, config.clientOptions)
 
 	// Connect client to server
 	clientSession, err := client.Connect(ctx, clientTransport, nil)
@@ -158,39 +190,21 @@ func (ts *Suite) Cleanup() {
 	}
 }
 
-// createTestServer creates a server instance for testing
-func createTestServer(t testing.TB, standardFiles map[string]string) *MCPTestServer {
-	// Create temporary standards directory
-	tempDir, err := os.MkdirTemp("", "agent-standards-test-*")
-	require.NoError(t, err)
-
-	t.Cleanup(func() {
-		_ = os.RemoveAll(tempDir)
-	})
-
-	// Create test standard files
-	for filename, content := range standardFiles {
-		filePath := tempDir + "/" + filename
-		err := os.WriteFile(filePath, []byte(content), testFilePermissions)
-		require.NoError(t, err)
+// createTestServer creates a server instance for testing.
+// If loader is nil, the standard files are written to a temporary standards folder read by a file loader.
+func createTestServer(
+	t testing.TB, standardFiles map[string]string, loader server.StandardLoader, buildInfo server.BuildInfo,
+) *MCPTestServer {
+	var cfg *config.Config
+	if loader != nil {
+		// Injected loaders need neither a standards folder nor log files
+		cfg = config.Default()
+		cfg.LogLevel = string(config.LogLevelNone)
+	} else {
+		cfg = setupStandardsFolder(t, standardFiles)
+		loader = standards.NewFileStandardLoader(cfg.GetFolder())
 	}
 
-	// Set up environment
-	oldStandardsFolder := os.Getenv("AGENT_STANDARDS_MCP_FOLDER")
-	_ = os.Setenv("AGENT_STANDARDS_MCP_FOLDER", tempDir)
-
-	t.Cleanup(func() {
-		if oldStandardsFolder != "" {
-			_ = os.Setenv("AGENT_STANDARDS_MCP_FOLDER", oldStandardsFolder)
-		} else {
-			_ = os.Unsetenv("AGENT_STANDARDS_MCP_FOLDER")
-		}
-	})
-
-	// Load configuration
-	cfg, err := config.Load()
-	require.NoError(t, err)
-
 	// Create logger
 	loggerFactory := logging.NewLoggerFactory()
 	structuredLogger, err := loggerFactory.CreateStructuredLogger(cfg)
@@ -208,29 +222,66 @@ func createTestServer(t testing.TB, standardFiles map[string]string) *MCPTestSer
 
 	t.Cleanup(func() {
 		if auditLogger != nil {
-			// audit logger doesn't have Close method
-			_ = auditLogger
+			_ = auditLogger.Close()
 		}
 	})
 
-	// Create standard loader
-	standardLoader := standards.NewFileStandardLoader()
-
 	// Create MCP server
-	mcpServer, err := server.New(cfg, structuredLogger, auditLogger, standardLoader)
+	mcpServer, err := server.New(cfg, structuredLogger, auditLogger, loader, buildInfo)
 	require.NoError(t, err)
 
 	// Register tools
 	err = mcpServer.RegisterTools()
 	require.NoError(t, err)
 
+	// Register resources
+	err = mcpServer.RegisterResources()
+	require.NoError(t, err)
+
 	return &MCPTestServer{
 		Server:         mcpServer,
 		Config:         cfg,
-		StandardLoader: standardLoader,
+		StandardLoader: loader,
 	}
 }
 
+// setupStandardsFolder writes the standard files to a temporary standards folder
+// and loads the configuration pointing to it.
+func setupStandardsFolder(t testing.TB, standardFiles map[string]string) *config.Config {
+	// Create temporary standards directory
+	tempDir, err := os.MkdirTemp("", "agent-standards-test-*")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = os.RemoveAll(tempDir)
+	})
+
+	// Create test standard files
+	for filename, content := range standardFiles {
+		filePath := tempDir + "/" + filename
+		err := os.WriteFile(filePath, []byte(content), testFilePermissions)
+		require.NoError(t, err)
+	}
+
+	// Set up environment
+	oldStandardsFolder := os.Getenv("AGENT_STANDARDS_MCP_FOLDER")
+	_ = os.Setenv("AGENT_STANDARDS_MCP_FOLDER", tempDir)
+
+	t.Cleanup(func() {
+		if oldStandardsFolder != "" {
+			_ = os.Setenv("AGENT_STANDARDS_MCP_FOLDER", oldStandardsFolder)
+		} else {
+			_ = os.Unsetenv("AGENT_STANDARDS_MCP_FOLDER")
+		}
+	})
+
+	// Load configuration
+	cfg, err := config.Load()
+	require.NoError(t, err)
+
+	return cfg
+}
+
 // setupCommandTransport sets up a command transport for real subprocess testing
 func setupCommandTransport(_ *testing.T, server *MCPTestServer) mcp.Transport {
 	// Calculate project root directory to ensure subprocess runs from correct location
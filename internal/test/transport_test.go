@@ -2,9 +2,12 @@ package test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/n-r-w/agent-standards-mcp/internal/server"
 	"github.com/stretchr/testify/require"
 )
 
@@ -14,7 +17,8 @@ func TestTransport_InMemory(t *testing.T) {
 	defer suite.Cleanup()
 
 	// Verify that expected tools are available
-	expectedTools := []string{"list_standards", "get_standards"}
+	expectedTools := []string{"list_standards", "get_standards", "get_standard_meta", "list_tags", "server_info",
+		"get_standards_by_path"}
 	AssertToolsAvailable(t, suite, expectedTools)
 
 	// Test basic list_standards functionality
@@ -46,7 +50,8 @@ func TestTransport_ConcurrentConnections(t *testing.T) {
 	defer suite2.Cleanup()
 
 	// Both should be able to discover tools
-	expectedTools := []string{"list_standards", "get_standards"}
+	expectedTools := []string{"list_standards", "get_standards", "get_standard_meta", "list_tags", "server_info",
+		"get_standards_by_path"}
 	AssertToolsAvailable(t, suite1, expectedTools)
 	AssertToolsAvailable(t, suite2, expectedTools)
 
@@ -85,7 +90,8 @@ func TestTransport_CustomClientInfo(t *testing.T) {
 	defer suite.Cleanup()
 
 	// Verify that expected tools are available
-	expectedTools := []string{"list_standards", "get_standards"}
+	expectedTools := []string{"list_standards", "get_standards", "get_standard_meta", "list_tags", "server_info",
+		"get_standards_by_path"}
 	AssertToolsAvailable(t, suite, expectedTools)
 
 	// Test that tool calls work with custom client
@@ -94,13 +100,67 @@ func TestTransport_CustomClientInfo(t *testing.T) {
 	AssertStandardListCount(t, plainText, 5)
 }
 
+// TestTransport_BuildInfo tests that the injected build info reaches the handshake and the server_info tool
+func TestTransport_BuildInfo(t *testing.T) {
+	buildInfo := server.BuildInfo{Version: "1.2.3", Commit: "abc1234", Date: "2026-01-31", BuiltBy: "ci"}
+	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()), WithBuildInfo(buildInfo))
+	defer suite.Cleanup()
+
+	serverInfo := suite.ClientSession.InitializeResult().ServerInfo
+	require.Equal(t, "agent-standards-mcp", serverInfo.Name)
+	require.Equal(t, "1.2.3", serverInfo.Version)
+
+	result := AssertToolCallSuccess(t, suite, "server_info", map[string]any{})
+	require.JSONEq(t,
+		`{"name": "agent-standards-mcp", "version": "1.2.3", "commit": "abc1234", "date": "2026-01-31", "built_by": "ci",
+		"metrics": {"tool_calls": {}, "standards_served": 0, "bytes_delivered": 0, "errors": {}}}`,
+		AssertPlainTextInput(t, result))
+}
+
+// TestTransport_UsageMetrics tests that server_info reports the usage of the tools called before it
+func TestTransport_UsageMetrics(t *testing.T) {
+	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+	defer suite.Cleanup()
+
+	AssertToolCallSuccess(t, suite, "list_standards", map[string]any{})
+	first := AssertToolCallSuccess(t, suite, "get_standards", map[string]any{
+		"standard_names": []string{"standard1", "standard2"},
+	})
+	second := AssertToolCallSuccess(t, suite, "get_standards", map[string]any{
+		"standard_names": []string{"standard1", "nonexistent"},
+	})
+	AssertToolCallError(t, suite, "get_standard_meta", map[string]any{"name": "nonexistent"})
+	AssertToolCallError(t, suite, "get_standards_by_path", map[string]any{"paths": []string{"../outside.md"}})
+
+	result := AssertToolCallSuccess(t, suite, "server_info", map[string]any{})
+
+	var info struct {
+		Metrics struct {
+			ToolCalls       map[string]int64 `json:"tool_calls"`
+			StandardsServed int64            `json:"standards_served"`
+			BytesDelivered  int64            `json:"bytes_delivered"`
+			Errors          map[string]int64 `json:"errors"`
+		} `json:"metrics"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(AssertPlainTextInput(t, result)), &info))
+
+	require.Equal(t, map[string]int64{
+		"list_standards": 1, "get_standards": 2, "get_standard_meta": 1, "get_standards_by_path": 1,
+	}, info.Metrics.ToolCalls)
+	require.Equal(t, int64(3), info.Metrics.StandardsServed)
+	require.Greater(t, info.Metrics.BytesDelivered,
+		int64(len(AssertPlainTextInput(t, first))+len(AssertPlainTextInput(t, second))))
+	require.Equal(t, map[string]int64{"NOT_FOUND": 1, "TOOL_ERROR": 1}, info.Metrics.Errors)
+}
+
 // TestTransport_EmptyStandards tests with empty standards directory
 func TestTransport_EmptyStandards(t *testing.T) {
 	suite := NewTestSuite(t, WithCustomStandardFiles(EmptyStandardFiles()))
 	defer suite.Cleanup()
 
 	// Verify that expected tools are available even with empty standards
-	expectedTools := []string{"list_standards", "get_standards"}
+	expectedTools := []string{"list_standards", "get_standards", "get_standard_meta", "list_tags", "server_info",
+		"get_standards_by_path"}
 	AssertToolsAvailable(t, suite, expectedTools)
 
 	// Test list_standards returns empty result
@@ -131,7 +191,8 @@ func TestTransport_ToolDiscoveryVerifiesTools(t *testing.T) {
 
 		// Verify that tool is one of the expected tools
 		switch tool.Name {
-		case "list_standards", "get_standards":
+		case "list_standards", "get_standards", "get_standard_meta", "list_tags", "server_info",
+			"get_standards_by_path":
 			// Expected tools - OK
 		default:
 			t.Errorf("Unexpected tool found: %s", tool.Name)
@@ -148,7 +209,8 @@ func TestTransport_CommandTransport(t *testing.T) {
 	defer suite.Cleanup()
 
 	// Verify that expected tools are available
-	expectedTools := []string{"list_standards", "get_standards"}
+	expectedTools := []string{"list_standards", "get_standards", "get_standard_meta", "list_tags", "server_info",
+		"get_standards_by_path"}
 	AssertToolsAvailable(t, suite, expectedTools)
 
 	// Test basic list_standards functionality
@@ -159,3 +221,78 @@ func TestTransport_CommandTransport(t *testing.T) {
 	AssertStandardListCount(t, plainText, 5)
 	AssertMultipleStandardsFormat(t, plainText)
 }
+
+// TestTransport_ConfiguredToolDescriptions tests that configured tool descriptions replace the built-in ones
+func TestTransport_ConfiguredToolDescriptions(t *testing.T) {
+	t.Setenv("AGENT_STANDARDS_MCP_LIST_DESC", "Lists the platform team standards")
+	t.Setenv("AGENT_STANDARDS_MCP_GET_DESC", "Loads platform team standards by name")
+
+	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+	defer suite.Cleanup()
+
+	tools, err := suite.ClientSession.ListTools(context.Background(), &mcp.ListToolsParams{
+		Meta:   mcp.Meta{},
+		Cursor: "",
+	})
+	require.NoError(t, err, "Failed to get tools from MCP server")
+
+	descriptions := make(map[string]string, len(tools.Tools))
+	for _, tool := range tools.Tools {
+		descriptions[tool.Name] = tool.Description
+	}
+
+	require.Equal(t, "Lists the platform team standards", descriptions["list_standards"])
+	require.Equal(t, "Loads platform team standards by name", descriptions["get_standards"])
+}
+
+// TestTransport_StructuredContentMatchesOutputSchema tests that the structured content of each tool
+// conforms to the output schema the tool advertises
+func TestTransport_StructuredContentMatchesOutputSchema(t *testing.T) {
+	t.Setenv("AGENT_STANDARDS_MCP_ENABLE_CONFIG_TOOL", "true")
+
+	suite := NewTestSuite(t, WithCustomStandardFiles(DefaultStandardFiles()))
+	defer suite.Cleanup()
+
+	tools, err := suite.ClientSession.ListTools(context.Background(), &mcp.ListToolsParams{
+		Meta:   mcp.Meta{},
+		Cursor: "",
+	})
+	require.NoError(t, err, "Failed to get tools from MCP server")
+
+	calls := map[string][]map[string]any{
+		"list_standards": {{}, {"names_only": true}},
+		"get_standards": {
+			{"standard_names": []string{"standard1"}},
+			{"standard_names": []string{"standard1", "nonexistent"}},
+		},
+		"get_standard_meta": {{"name": "standard1"}},
+		"list_tags":         {{}},
+		"server_info":       {{}},
+		"get_config":        {{}},
+		"get_standards_by_path": {
+			{"paths": []string{"standard1.md"}},
+			{"paths": []string{"standard1.md", "nonexistent.md"}},
+		},
+	}
+
+	for _, tool := range tools.Tools {
+		t.Run(tool.Name, func(t *testing.T) {
+			require.NotNil(t, tool.OutputSchema, "Tool should have an output schema")
+
+			schemaJSON, err := json.Marshal(tool.OutputSchema)
+			require.NoError(t, err)
+
+			var schema jsonschema.Schema
+			require.NoError(t, json.Unmarshal(schemaJSON, &schema))
+			resolved, err := schema.Resolve(nil)
+			require.NoError(t, err, "Output schema should be valid")
+
+			require.NotEmpty(t, calls[tool.Name], "No test calls for tool %s", tool.Name)
+			for _, args := range calls[tool.Name] {
+				result := AssertToolCallSuccess(t, suite, tool.Name, args)
+				require.NoError(t, resolved.Validate(result.StructuredContent),
+					"Structured content of %s with %v should match the output schema", tool.Name, args)
+			}
+		})
+	}
+}